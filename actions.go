@@ -0,0 +1,132 @@
+package github
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Workflow is a GitHub Actions workflow.
+type Workflow struct {
+	ID       int64     `json:"id"`
+	Name     string    `json:"name"`
+	Path     string    `json:"path"`  // ".github/workflows/build.yml"
+	State    string    `json:"state"` // "active", "disabled_manually", ...
+	HTMLURL  string    `json:"html_url"`
+	BadgeURL string    `json:"badge_url"`
+	Created  time.Time `json:"created_at"`
+	Updated  time.Time `json:"updated_at"`
+}
+
+// WorkflowRun is a run of a workflow.
+type WorkflowRun struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	WorkflowID int64      `json:"workflow_id"`
+	RunNumber  int        `json:"run_number"`
+	RunAttempt int        `json:"run_attempt"`
+	Event      string     `json:"event"`      // "push", "pull_request", "schedule", ...
+	Status     string     `json:"status"`     // "queued", "in_progress", "completed", ...
+	Conclusion string     `json:"conclusion"` // "success", "failure", "cancelled", ... once completed
+	HeadBranch string     `json:"head_branch"`
+	HeadSHA    string     `json:"head_sha"`
+	Actor      User       `json:"actor"`
+	HTMLURL    string     `json:"html_url"`
+	RunStarted *time.Time `json:"run_started_at"`
+	Created    time.Time  `json:"created_at"`
+	Updated    time.Time  `json:"updated_at"`
+}
+
+// Duration returns how long the latest attempt of a completed run took,
+// or zero if the run has not completed.
+func (r WorkflowRun) Duration() time.Duration {
+	if r.Status != "completed" || r.RunStarted == nil {
+		return 0
+	}
+	return r.Updated.Sub(*r.RunStarted)
+}
+
+// WorkflowJob is a job in a workflow run.
+type WorkflowJob struct {
+	ID          int64      `json:"id"`
+	RunID       int64      `json:"run_id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	HTMLURL     string     `json:"html_url"`
+	RunnerName  string     `json:"runner_name"`
+	Labels      []string   `json:"labels"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"` // nil until completed
+	Steps       []struct {
+		Number      int        `json:"number"`
+		Name        string     `json:"name"`
+		Status      string     `json:"status"`
+		Conclusion  string     `json:"conclusion"`
+		StartedAt   *time.Time `json:"started_at"`
+		CompletedAt *time.Time `json:"completed_at"`
+	} `json:"steps"`
+}
+
+// LoadWorkflows loads the workflows of the repository.
+func (c *Client) LoadWorkflows(repo string) ([]Workflow, error) {
+	link := c.apiURL("repos", repo, "actions/workflows") + "?per_page=100"
+	workflows, err := c.loadSliceField(link, "workflows", Workflow{})
+	if err != nil {
+		return nil, err
+	}
+	return workflows.([]Workflow), nil
+}
+
+// LoadWorkflows is a wrapper around DefaultClient.LoadWorkflows.
+func LoadWorkflows(repo string) ([]Workflow, error) {
+	return DefaultClient.LoadWorkflows(repo)
+}
+
+// LoadWorkflowRuns loads the runs of the workflow, given by ID or file
+// name ("build.yml"), newest first. All runs in the repository are loaded
+// if workflow is empty. The query may filter by "status" (a status or a
+// conclusion), "branch", "event", "actor" and "created" (a date range
+// such as "2024-01-01..2024-01-31").
+func (c *Client) LoadWorkflowRuns(repo, workflow string, query url.Values) ([]WorkflowRun, error) {
+	link := c.apiURL("repos", repo, "actions/runs")
+	if workflow != "" {
+		link = c.apiURL("repos", repo, "actions/workflows", workflow, "runs")
+	}
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("per_page", "100")
+	runs, err := c.loadSliceField(link+"?"+q.Encode(), "workflow_runs", WorkflowRun{})
+	if err != nil {
+		return nil, err
+	}
+	return runs.([]WorkflowRun), nil
+}
+
+// LoadWorkflowRuns is a wrapper around DefaultClient.LoadWorkflowRuns.
+func LoadWorkflowRuns(repo, workflow string, query url.Values) ([]WorkflowRun, error) {
+	return DefaultClient.LoadWorkflowRuns(repo, workflow, query)
+}
+
+// LoadWorkflowRunJobs loads the jobs of the workflow run. The query may
+// set "filter" to "all" to include the jobs of earlier attempts.
+func (c *Client) LoadWorkflowRunJobs(repo string, runID int64, query url.Values) ([]WorkflowJob, error) {
+	link := c.apiURL("repos", repo, "actions/runs", strconv.FormatInt(runID, 10), "jobs")
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("per_page", "100")
+	jobs, err := c.loadSliceField(link+"?"+q.Encode(), "jobs", WorkflowJob{})
+	if err != nil {
+		return nil, err
+	}
+	return jobs.([]WorkflowJob), nil
+}
+
+// LoadWorkflowRunJobs is a wrapper around DefaultClient.LoadWorkflowRunJobs.
+func LoadWorkflowRunJobs(repo string, runID int64, query url.Values) ([]WorkflowJob, error) {
+	return DefaultClient.LoadWorkflowRunJobs(repo, runID, query)
+}
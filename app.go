@@ -0,0 +1,194 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// App authenticates as a GitHub App, for the endpoints that require the
+// app's own JWT rather than user or installation credentials.
+type App struct {
+	ID  int64
+	Key *rsa.PrivateKey
+
+	// Client makes the requests; DefaultClient is used if nil.
+	Client *Client
+
+	mut          sync.Mutex
+	tokenSources map[int64]TokenSource // by installation ID
+}
+
+// NewApp returns an App for the given app ID and PEM encoded private key,
+// as downloaded from the app settings page.
+func NewApp(id int64, pemKey []byte) (*App, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("no PEM data in private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return &App{ID: id, Key: key}, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+	return &App{ID: id, Key: rsaKey}, nil
+}
+
+// JWT returns a newly signed token authenticating as the app, valid for
+// nine minutes.
+func (a *App) JWT() (string, error) {
+	now := time.Now()
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	claims := map[string]interface{}{
+		// Backdated to allow for clock drift, as recommended by GitHub.
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(a.ID, 10),
+	}
+
+	hbs, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	cbs, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(hbs) + "." + base64.RawURLEncoding.EncodeToString(cbs)
+
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.Key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func (a *App) client() *Client {
+	if a.Client != nil {
+		return a.Client
+	}
+	return DefaultClient
+}
+
+type Installation struct {
+	ID                  int64             `json:"id"`
+	AppID               int64             `json:"app_id"`
+	Account             User              `json:"account"`
+	TargetType          string            `json:"target_type"`
+	RepositorySelection string            `json:"repository_selection"`
+	Permissions         map[string]string `json:"permissions"`
+	Events              []string          `json:"events"`
+	HTMLURL             string            `json:"html_url"`
+	Created             time.Time         `json:"created_at"`
+	Updated             time.Time         `json:"updated_at"`
+}
+
+type InstallationToken struct {
+	Token               string            `json:"token"`
+	Expires             time.Time         `json:"expires_at"`
+	Permissions         map[string]string `json:"permissions"`
+	RepositorySelection string            `json:"repository_selection"`
+}
+
+// LoadAppInstallations loads all installations of the app.
+func (a *App) LoadAppInstallations() ([]Installation, error) {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return nil, err
+	}
+	insts, err := c.loadSlice(c.apiURL("app/installations"), Installation{}, withAuthorization("Bearer "+jwt))
+	if err != nil {
+		return nil, err
+	}
+	return insts.([]Installation), nil
+}
+
+// LoadInstallation loads the app's installation on the given organization.
+func (a *App) LoadInstallation(org string) (Installation, error) {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return Installation{}, err
+	}
+	link := c.apiURL("orgs", org, "installation")
+	var inst Installation
+	if err := c.requestInto(link, &inst, withAuthorization("Bearer "+jwt)); err != nil {
+		return Installation{}, err
+	}
+	return inst, nil
+}
+
+// LoadRepoInstallation loads the app's installation that covers the
+// repository.
+func (a *App) LoadRepoInstallation(repo string) (Installation, error) {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return Installation{}, err
+	}
+	link := c.apiURL("repos", repo, "installation")
+	var inst Installation
+	if err := c.requestInto(link, &inst, withAuthorization("Bearer "+jwt)); err != nil {
+		return Installation{}, err
+	}
+	return inst, nil
+}
+
+// InstallationTokenRequest restricts an installation token to some of the
+// repositories and permissions of the installation.
+type InstallationTokenRequest struct {
+	Repositories  []string          `json:"repositories,omitempty"` // names, without the owner
+	RepositoryIDs []int64           `json:"repository_ids,omitempty"`
+	Permissions   map[string]string `json:"permissions,omitempty"` // "contents": "read", ...
+}
+
+// CreateInstallationToken creates an access token for the installation,
+// valid for one hour.
+func (a *App) CreateInstallationToken(installationID int64) (InstallationToken, error) {
+	return a.CreateScopedInstallationToken(installationID, InstallationTokenRequest{})
+}
+
+// CreateScopedInstallationToken creates an access token for the
+// installation, valid for one hour, restricted as given in the request.
+// Tokens handed to other tools should be no more capable than necessary.
+func (a *App) CreateScopedInstallationToken(installationID int64, scope InstallationTokenRequest) (InstallationToken, error) {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return InstallationToken{}, err
+	}
+	link := c.apiURL("app/installations", strconv.FormatInt(installationID, 10), "access_tokens")
+	var tok InstallationToken
+	if err := c.request("POST", link, scope, &tok, withAuthorization("Bearer "+jwt)); err != nil {
+		return InstallationToken{}, err
+	}
+	return tok, nil
+}
+
+// LoadInstallationRepos loads the repositories the installation has been
+// granted access to.
+func (a *App) LoadInstallationRepos(installationID int64) ([]Repository, error) {
+	c := a.InstallationClient(installationID)
+	repos, err := c.loadSliceField(c.apiURL("installation/repositories"), "repositories", Repository{})
+	if err != nil {
+		return nil, err
+	}
+	return repos.([]Repository), nil
+}
@@ -0,0 +1,26 @@
+package github
+
+import (
+	"fmt"
+	"io"
+)
+
+// DownloadArchive streams a source archive of the repository at the given
+// branch, tag or commit to w, returning the archive size. The format is
+// "tarball" (.tar.gz) or "zipball" (.zip).
+//
+// The API redirects to codeload.github.com. For private repositories the
+// redirect URL carries a short lived token of its own, so the client's
+// credentials are not sent to the other host.
+func (c *Client) DownloadArchive(repo, ref, format string, w io.Writer) (int64, error) {
+	if format != "tarball" && format != "zipball" {
+		return 0, fmt.Errorf("unknown archive format %q", format)
+	}
+	link := c.apiURL("repos", repo, format, escapePath(ref))
+	return c.download(link, w)
+}
+
+// DownloadArchive is a wrapper around DefaultClient.DownloadArchive.
+func DownloadArchive(repo, ref, format string, w io.Writer) (int64, error) {
+	return DefaultClient.DownloadArchive(repo, ref, format, w)
+}
@@ -0,0 +1,280 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrDigestMismatch is returned when downloaded asset data does not match
+// the size or digest reported by GitHub.
+var ErrDigestMismatch = errors.New("asset digest mismatch")
+
+// SHA256 returns the hex encoded SHA-256 digest GitHub reports for the
+// asset. The boolean is false when the asset carries no SHA-256 digest.
+func (a Asset) SHA256() (string, bool) {
+	const prefix = "sha256:"
+	if !strings.HasPrefix(a.Digest, prefix) {
+		return "", false
+	}
+	return strings.ToLower(strings.TrimPrefix(a.Digest, prefix)), true
+}
+
+// AssetVerifier is an io.Writer that hashes the asset data written to it,
+// for verification against the digest reported by GitHub.
+type AssetVerifier struct {
+	asset Asset
+	hash  hash.Hash
+	n     int64
+}
+
+func NewAssetVerifier(asset Asset) *AssetVerifier {
+	return &AssetVerifier{
+		asset: asset,
+		hash:  sha256.New(),
+	}
+}
+
+func (v *AssetVerifier) Write(bs []byte) (int, error) {
+	v.n += int64(len(bs))
+	return v.hash.Write(bs)
+}
+
+// Sum returns the hex encoded SHA-256 of the data written so far.
+func (v *AssetVerifier) Sum() string {
+	return hex.EncodeToString(v.hash.Sum(nil))
+}
+
+// Verify returns an error wrapping ErrDigestMismatch if the data written
+// does not match the asset size or digest. Assets without a digest are
+// verified by size only.
+func (v *AssetVerifier) Verify() error {
+	if v.n != int64(v.asset.Size) {
+		return fmt.Errorf("%s: %w (got %d bytes, expected %d)", v.asset.Name, ErrDigestMismatch, v.n, v.asset.Size)
+	}
+	if expected, ok := v.asset.SHA256(); ok {
+		if sum := v.Sum(); sum != expected {
+			return fmt.Errorf("%s: %w (got sha256:%s, expected sha256:%s)", v.asset.Name, ErrDigestMismatch, sum, expected)
+		}
+	}
+	return nil
+}
+
+// VerifyAsset reads r to the end and verifies the data against the asset.
+func VerifyAsset(asset Asset, r io.Reader) error {
+	v := NewAssetVerifier(asset)
+	if _, err := io.Copy(v, r); err != nil {
+		return err
+	}
+	return v.Verify()
+}
+
+// ProgressFunc is called as asset data is downloaded, with the number of
+// bytes written so far and the total asset size.
+type ProgressFunc func(asset Asset, written, total int64)
+
+// MultiError is a collection of errors from operations that run
+// independently of each other.
+type MultiError []error
+
+func (e MultiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DownloadAsset writes the asset data to w. The download goes through the
+// API, so that assets of private repositories can be downloaded with the
+// client's credentials. The progress function may be nil.
+func (c *Client) DownloadAsset(asset Asset, w io.Writer, progress ProgressFunc) error {
+	pw := &progressWriter{
+		w:        w,
+		asset:    asset,
+		progress: progress,
+	}
+	_, err := c.download(asset.URL, pw, withAccept("application/octet-stream"))
+	return err
+}
+
+// DownloadAsset is a wrapper around DefaultClient.DownloadAsset.
+func DownloadAsset(asset Asset, w io.Writer, progress ProgressFunc) error {
+	return DefaultClient.DownloadAsset(asset, w, progress)
+}
+
+// DownloadAllAssets downloads all assets of the given release into dir,
+// running up to concurrency downloads in parallel. Partially downloaded
+// assets are resumed, and existing files that already match the asset are
+// left alone. The progress function may be nil; it is not called
+// concurrently. All assets are attempted; failures are returned together
+// as a MultiError.
+func (c *Client) DownloadAllAssets(repo string, releaseID int, dir string, concurrency int, progress ProgressFunc) error {
+	rel, err := c.LoadRelease(repo, releaseID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var errs MultiError
+	if progress != nil {
+		// Downloads run concurrently, but progress is reported one
+		// call at a time.
+		var progressMut sync.Mutex
+		report := progress
+		progress = func(asset Asset, written, total int64) {
+			progressMut.Lock()
+			report(asset, written, total)
+			progressMut.Unlock()
+		}
+	}
+	sem := make(chan struct{}, concurrency)
+	for _, asset := range rel.Assets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(asset Asset) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			dst := filepath.Join(dir, filepath.Base(asset.Name))
+			if err := c.downloadAssetFile(asset, dst, progress); err != nil {
+				mut.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", asset.Name, err))
+				mut.Unlock()
+			}
+		}(asset)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DownloadAllAssets is a wrapper around DefaultClient.DownloadAllAssets.
+func DownloadAllAssets(repo string, releaseID int, dir string, concurrency int, progress ProgressFunc) error {
+	return DefaultClient.DownloadAllAssets(repo, releaseID, dir, concurrency, progress)
+}
+
+// downloadAssetFile downloads the asset to dst, via a ".part" file that is
+// resumed if it exists from a previous attempt.
+func (c *Client) downloadAssetFile(asset Asset, dst string, progress ProgressFunc) error {
+	if fd, err := os.Open(dst); err == nil {
+		err := VerifyAsset(asset, fd)
+		fd.Close()
+		if err == nil {
+			return nil
+		}
+	}
+
+	partial := dst + ".part"
+	fd, err := os.OpenFile(partial, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	offset, err := fd.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if offset >= int64(asset.Size) {
+		// Complete or bogus; start over.
+		offset = 0
+	}
+
+	if err := c.downloadAsset(asset, fd, offset, progress); err != nil {
+		return err
+	}
+
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := VerifyAsset(asset, fd); err != nil {
+		// Don't try to resume corrupt data next time.
+		os.Remove(partial)
+		return err
+	}
+	if err := fd.Close(); err != nil {
+		return err
+	}
+	return os.Rename(partial, dst)
+}
+
+// downloadAsset writes the asset data to fd, starting at offset. If the
+// server does not honor the range request the file is truncated and
+// downloaded from the start.
+func (c *Client) downloadAsset(asset Asset, fd *os.File, offset int64, progress ProgressFunc) error {
+	req, err := c.newRequest("GET", asset.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/octet-stream")
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		offset = 0
+	default:
+		return responseError(resp)
+	}
+
+	if err := fd.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := fd.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	w := &progressWriter{
+		w:        fd,
+		asset:    asset,
+		written:  offset,
+		progress: progress,
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+type progressWriter struct {
+	w        io.Writer
+	asset    Asset
+	written  int64
+	progress ProgressFunc
+}
+
+func (p *progressWriter) Write(bs []byte) (int, error) {
+	n, err := p.w.Write(bs)
+	p.written += int64(n)
+	if p.progress != nil {
+		p.progress(p.asset, p.written, int64(p.asset.Size))
+	}
+	return n, err
+}
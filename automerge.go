@@ -0,0 +1,91 @@
+package github
+
+import (
+	"strings"
+)
+
+const pullRequestIDQuery = `query($owner: String!, $name: String!, $number: Int!) {
+  repository(owner: $owner, name: $name) {
+    pullRequest(number: $number) {
+      id
+    }
+  }
+}`
+
+const enableAutoMergeMutation = `mutation($id: ID!, $method: PullRequestMergeMethod!) {
+  enablePullRequestAutoMerge(input: {pullRequestId: $id, mergeMethod: $method}) {
+    clientMutationId
+  }
+}`
+
+const disableAutoMergeMutation = `mutation($id: ID!) {
+  disablePullRequestAutoMerge(input: {pullRequestId: $id}) {
+    clientMutationId
+  }
+}`
+
+// EnableAutoMerge arms auto-merge on the pull request, so that it is
+// merged with the given method once all requirements are met. An empty
+// method means MergeMethodMerge.
+func (c *Client) EnableAutoMerge(repo string, number int, method MergeMethod) error {
+	id, err := c.pullRequestNodeID(repo, number)
+	if err != nil {
+		return err
+	}
+	if method == "" {
+		method = MergeMethodMerge
+	}
+	vars := map[string]interface{}{
+		"id":     id,
+		"method": strings.ToUpper(string(method)),
+	}
+	return c.Mutate(enableAutoMergeMutation, vars, nil)
+}
+
+// EnableAutoMerge is a wrapper around DefaultClient.EnableAutoMerge.
+func EnableAutoMerge(repo string, number int, method MergeMethod) error {
+	return DefaultClient.EnableAutoMerge(repo, number, method)
+}
+
+// DisableAutoMerge disarms auto-merge on the pull request.
+func (c *Client) DisableAutoMerge(repo string, number int) error {
+	id, err := c.pullRequestNodeID(repo, number)
+	if err != nil {
+		return err
+	}
+	return c.Mutate(disableAutoMergeMutation, map[string]interface{}{"id": id}, nil)
+}
+
+// DisableAutoMerge is a wrapper around DefaultClient.DisableAutoMerge.
+func DisableAutoMerge(repo string, number int) error {
+	return DefaultClient.DisableAutoMerge(repo, number)
+}
+
+// pullRequestNodeID returns the GraphQL node ID of the pull request.
+func (c *Client) pullRequestNodeID(repo string, number int) (string, error) {
+	owner, name := splitRepo(repo)
+	var res struct {
+		Repository struct {
+			PullRequest struct {
+				ID string
+			}
+		}
+	}
+	vars := map[string]interface{}{
+		"owner":  owner,
+		"name":   name,
+		"number": number,
+	}
+	if err := c.Query(pullRequestIDQuery, vars, &res); err != nil {
+		return "", err
+	}
+	return res.Repository.PullRequest.ID, nil
+}
+
+// splitRepo splits "owner/name" into owner and name.
+func splitRepo(repo string) (string, string) {
+	if i := strings.IndexByte(repo, '/'); i >= 0 {
+		return repo[:i], repo[i+1:]
+	}
+	return repo, ""
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"net/url"
+)
+
+type Branch struct {
+	Name   string `json:"name"`
+	Commit struct {
+		SHA string `json:"sha"`
+		URL string `json:"url"`
+	} `json:"commit"`
+	Protected bool `json:"protected"`
+
+	// Protection summarizes the branch protection. It is only present when
+	// loading a single branch, and only complete with admin access.
+	Protection struct {
+		Enabled              bool `json:"enabled"`
+		RequiredStatusChecks struct {
+			EnforcementLevel string   `json:"enforcement_level"` // "off", "non_admins" or "everyone"
+			Contexts         []string `json:"contexts"`
+		} `json:"required_status_checks"`
+	} `json:"protection"`
+}
+
+// LoadBranches loads the branches of the repository. The query may set
+// "protected" to "true" or "false" to load only protected or unprotected
+// branches.
+func (c *Client) LoadBranches(repo string, query url.Values) ([]Branch, error) {
+	link := c.apiURL("repos", repo, "branches")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	branches, err := c.loadSlice(link, Branch{})
+	if err != nil {
+		return nil, err
+	}
+	return branches.([]Branch), nil
+}
+
+// LoadBranches is a wrapper around DefaultClient.LoadBranches.
+func LoadBranches(repo string, query url.Values) ([]Branch, error) {
+	return DefaultClient.LoadBranches(repo, query)
+}
+
+// LoadBranch loads the branch, including its protection summary.
+func (c *Client) LoadBranch(repo, name string) (Branch, error) {
+	link := c.apiURL("repos", repo, "branches", escapePath(name))
+	var branch Branch
+	if err := c.requestInto(link, &branch); err != nil {
+		return Branch{}, err
+	}
+	return branch, nil
+}
+
+// LoadBranch is a wrapper around DefaultClient.LoadBranch.
+func LoadBranch(repo, name string) (Branch, error) {
+	return DefaultClient.LoadBranch(repo, name)
+}
+
+// RenameBranch renames a branch. Renaming the default branch also updates
+// the repository default branch, and open pull requests and branch
+// protection rules follow the rename.
+func (c *Client) RenameBranch(repo, oldName, newName string) (Branch, error) {
+	link := c.apiURL("repos", repo, "branches", escapePath(oldName), "rename")
+	var branch Branch
+	if err := c.request("POST", link, map[string]string{"new_name": newName}, &branch); err != nil {
+		return Branch{}, err
+	}
+	return branch, nil
+}
+
+// RenameBranch is a wrapper around DefaultClient.RenameBranch.
+func RenameBranch(repo, oldName, newName string) (Branch, error) {
+	return DefaultClient.RenameBranch(repo, oldName, newName)
+}
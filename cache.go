@@ -0,0 +1,135 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+// Cache stores the responses that Transport makes conditional requests
+// for. Keys start with the request URL. Implementations must be safe for
+// concurrent use.
+type Cache interface {
+	// Get returns the data stored for the key, if any.
+	Get(key string) ([]byte, bool)
+
+	// Set stores data for the key, replacing any previous data.
+	Set(key string, data []byte)
+
+	// DeletePrefix removes the data for all keys starting with prefix,
+	// ignoring case.
+	DeletePrefix(prefix string)
+}
+
+// DefaultMemoryCacheSize is the size of a MemoryCache that does not set
+// MaxSize.
+const DefaultMemoryCacheSize = 64 << 20
+
+// MemoryCache is a Cache that keeps responses in memory, evicting the
+// least recently used when full. The zero value is ready to use.
+type MemoryCache struct {
+	// MaxSize is the total size in bytes of the responses kept.
+	// DefaultMemoryCacheSize is used if zero.
+	MaxSize int
+
+	mut  sync.Mutex
+	data map[string]*list.Element
+	lru  list.List // of *memoryCacheEntry, most recently used first
+	size int
+}
+
+type memoryCacheEntry struct {
+	key  string
+	data []byte
+}
+
+func (e *memoryCacheEntry) size() int {
+	return len(e.key) + len(e.data)
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	el, ok := c.data[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return el.Value.(*memoryCacheEntry).data, true
+}
+
+func (c *MemoryCache) Set(key string, data []byte) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.data == nil {
+		c.data = make(map[string]*list.Element)
+	}
+	if el, ok := c.data[key]; ok {
+		c.remove(el)
+	}
+
+	max := c.MaxSize
+	if max <= 0 {
+		max = DefaultMemoryCacheSize
+	}
+	e := &memoryCacheEntry{key: key, data: data}
+	if e.size() > max {
+		return
+	}
+	for c.size+e.size() > max {
+		c.remove(c.lru.Back())
+	}
+	c.data[key] = c.lru.PushFront(e)
+	c.size += e.size()
+}
+
+func (c *MemoryCache) DeletePrefix(prefix string) {
+	prefix = strings.ToLower(prefix)
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	for key, el := range c.data {
+		if strings.HasPrefix(strings.ToLower(key), prefix) {
+			c.remove(el)
+		}
+	}
+}
+
+func (c *MemoryCache) remove(el *list.Element) {
+	e := c.lru.Remove(el).(*memoryCacheEntry)
+	delete(c.data, e.key)
+	c.size -= e.size()
+}
+
+// cacheable returns true for requests that we may make conditional.
+// Requests that are already conditional or partial are left alone.
+func cacheable(req *http.Request) bool {
+	return req.Method == "GET" &&
+		req.Header.Get("If-None-Match") == "" &&
+		req.Header.Get("If-Modified-Since") == "" &&
+		req.Header.Get("Range") == ""
+}
+
+// cacheKey identifies a response by URL, media type and credentials, so
+// that different users never see each other's responses.
+func cacheKey(req *http.Request) string {
+	h := sha256.New()
+	h.Write([]byte(req.Header.Get("Authorization")))
+	return req.URL.String() + " " + req.Header.Get("Accept") + " " + hex.EncodeToString(h.Sum(nil))
+}
+
+// encodeResponse serializes the response in HTTP/1.1 wire format. The
+// response body is read and replaced.
+func encodeResponse(resp *http.Response) ([]byte, error) {
+	return httputil.DumpResponse(resp, true)
+}
+
+// decodeResponse reads a response serialized by encodeResponse.
+func decodeResponse(data []byte, req *http.Request) (*http.Response, error) {
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+}
@@ -0,0 +1,196 @@
+package github
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// ReleaseChanges describes what has changed since a release.
+type ReleaseChanges struct {
+	Repo string
+	Base string // tag of the previous release
+	Head string
+
+	// PullRequests are the pull requests merged since the previous
+	// release, in the order they were merged.
+	PullRequests []PullRequest
+
+	// Commits are the commits that were not merged through a pull
+	// request, oldest first.
+	Commits []Commit
+}
+
+// PullRequestGroup is a group of pull requests sharing a label.
+type PullRequestGroup struct {
+	Label        string
+	PullRequests []PullRequest
+}
+
+// LoadChangesSinceRelease finds the latest stable release of the
+// repository, by version, and returns the pull requests and commits
+// between it and head, which is typically the default branch name.
+func (c *Client) LoadChangesSinceRelease(repo, head string) (ReleaseChanges, error) {
+	rels, err := c.LoadReleases(repo)
+	if err != nil {
+		return ReleaseChanges{}, err
+	}
+	prev, ok := LatestStable(rels)
+	if !ok {
+		return ReleaseChanges{}, errors.New("no previous release")
+	}
+	return c.LoadChangesBetween(repo, prev.TagName, head)
+}
+
+// LoadChangesSinceRelease is a wrapper around DefaultClient.LoadChangesSinceRelease.
+func LoadChangesSinceRelease(repo, head string) (ReleaseChanges, error) {
+	return DefaultClient.LoadChangesSinceRelease(repo, head)
+}
+
+// LoadChangesBetween returns the pull requests and commits between base
+// and head, which must be a branch name for pull requests to be found.
+// Pull requests are those merged into the branch whose merge commit is
+// among the commits; for a pull request merged by rebasing that is only
+// its last commit, and the others are listed as commits.
+func (c *Client) LoadChangesBetween(repo, base, head string) (ReleaseChanges, error) {
+	cmp, err := c.CompareCommits(repo, base, head)
+	if err != nil {
+		return ReleaseChanges{}, err
+	}
+
+	changes := ReleaseChanges{Repo: repo, Base: base, Head: head}
+	if len(cmp.Commits) == 0 {
+		return changes, nil
+	}
+	commits := make(map[string]Commit, len(cmp.Commits))
+	oldest := cmp.Commits[0].Commit.Committer.Date
+	for _, commit := range cmp.Commits {
+		commits[commit.SHA] = commit
+		if commit.Commit.Committer.Date.Before(oldest) {
+			oldest = commit.Commit.Committer.Date
+		}
+	}
+
+	prs, err := c.loadMergedPullRequests(repo, head, oldest.Add(-time.Hour))
+	if err != nil {
+		return ReleaseChanges{}, err
+	}
+
+	// The first parent chain from head is the branch itself; commits off
+	// it came in through a merge commit.
+	mainline := make(map[string]bool)
+	for sha := cmp.Commits[len(cmp.Commits)-1].SHA; commits[sha].SHA != ""; {
+		mainline[sha] = true
+		parents := commits[sha].Parents
+		if len(parents) == 0 {
+			break
+		}
+		sha = parents[0].SHA
+	}
+
+	covered := make(map[string]bool) // commits brought in by a pull request
+	for _, pr := range prs {
+		merge, ok := commits[pr.MergeCommitSHA]
+		if !ok {
+			continue
+		}
+		changes.PullRequests = append(changes.PullRequests, pr)
+		covered[merge.SHA] = true
+		var queue []string
+		for i := 1; i < len(merge.Parents); i++ {
+			queue = append(queue, merge.Parents[i].SHA)
+		}
+		for len(queue) > 0 {
+			sha := queue[0]
+			queue = queue[1:]
+			commit, ok := commits[sha]
+			if !ok || mainline[sha] || covered[sha] {
+				continue
+			}
+			covered[sha] = true
+			for _, p := range commit.Parents {
+				queue = append(queue, p.SHA)
+			}
+		}
+	}
+	for _, commit := range cmp.Commits {
+		if !covered[commit.SHA] {
+			changes.Commits = append(changes.Commits, commit)
+		}
+	}
+
+	sort.SliceStable(changes.PullRequests, func(a, b int) bool {
+		return changes.PullRequests[a].MergedAt.Before(*changes.PullRequests[b].MergedAt)
+	})
+	return changes, nil
+}
+
+// loadMergedPullRequests loads the pull requests merged into the branch,
+// stopping at those last updated before since.
+func (c *Client) loadMergedPullRequests(repo, branch string, since time.Time) ([]PullRequest, error) {
+	q := url.Values{
+		"state":     {"closed"},
+		"base":      {branch},
+		"sort":      {"updated"},
+		"direction": {"desc"},
+		"per_page":  {"100"},
+	}
+	link := c.apiURL("repos", repo, "pulls") + "?" + q.Encode()
+
+	var prs []PullRequest
+	for link != "" {
+		var page []PullRequest
+		next, err := c.loadPage(link, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range page {
+			if pr.Updated.Before(since) {
+				return prs, nil
+			}
+			if pr.MergedAt != nil && pr.Base.Ref == branch {
+				prs = append(prs, pr)
+			}
+		}
+		link = next
+	}
+	return prs, nil
+}
+
+// LoadChangesBetween is a wrapper around DefaultClient.LoadChangesBetween.
+func LoadChangesBetween(repo, base, head string) (ReleaseChanges, error) {
+	return DefaultClient.LoadChangesBetween(repo, base, head)
+}
+
+// GroupByLabel groups the pull requests by label, ordered by label name.
+// A pull request with several labels is in several groups; pull requests
+// without labels are in a last group with an empty label.
+func (c ReleaseChanges) GroupByLabel() []PullRequestGroup {
+	var groups []PullRequestGroup
+	idx := make(map[string]int)
+	add := func(label string, pr PullRequest) {
+		n, ok := idx[label]
+		if !ok {
+			n = len(groups)
+			idx[label] = n
+			groups = append(groups, PullRequestGroup{Label: label})
+		}
+		groups[n].PullRequests = append(groups[n].PullRequests, pr)
+	}
+	for _, pr := range c.PullRequests {
+		if len(pr.Labels) == 0 {
+			add("", pr)
+		}
+		for _, l := range pr.Labels {
+			add(l.Name, pr)
+		}
+	}
+	sort.SliceStable(groups, func(a, b int) bool {
+		if groups[a].Label == "" || groups[b].Label == "" {
+			return groups[b].Label == "" && groups[a].Label != ""
+		}
+		return groups[a].Label < groups[b].Label
+	})
+	return groups
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"strconv"
+	"time"
+)
+
+type CheckSuite struct {
+	ID         int64  `json:"id"`
+	HeadBranch string `json:"head_branch"`
+	HeadSHA    string `json:"head_sha"`
+	Status     string `json:"status"`     // "queued", "in_progress" or "completed"
+	Conclusion string `json:"conclusion"` // "success", "failure", ... once completed
+	App        struct {
+		ID   int64  `json:"id"`
+		Slug string `json:"slug"`
+		Name string `json:"name"`
+	} `json:"app"`
+	LatestCheckRunsCount int       `json:"latest_check_runs_count"`
+	Created              time.Time `json:"created_at"`
+	Updated              time.Time `json:"updated_at"`
+}
+
+// LoadCheckSuites loads the check suites for the ref, which may be a
+// commit SHA, branch or tag name.
+func (c *Client) LoadCheckSuites(repo, ref string) ([]CheckSuite, error) {
+	link := c.apiURL("repos", repo, "commits", escapePath(ref), "check-suites")
+	suites, err := c.loadSliceField(link, "check_suites", CheckSuite{})
+	if err != nil {
+		return nil, err
+	}
+	return suites.([]CheckSuite), nil
+}
+
+// LoadCheckSuites is a wrapper around DefaultClient.LoadCheckSuites.
+func LoadCheckSuites(repo, ref string) ([]CheckSuite, error) {
+	return DefaultClient.LoadCheckSuites(repo, ref)
+}
+
+// RerequestCheckSuite asks the app that owns the check suite to run all
+// its checks again.
+func (c *Client) RerequestCheckSuite(repo string, suiteID int64) error {
+	link := c.apiURL("repos", repo, "check-suites", strconv.FormatInt(suiteID, 10), "rerequest")
+	return c.request("POST", link, nil, nil)
+}
+
+// RerequestCheckSuite is a wrapper around DefaultClient.RerequestCheckSuite.
+func RerequestCheckSuite(repo string, suiteID int64) error {
+	return DefaultClient.RerequestCheckSuite(repo, suiteID)
+}
+
+type CheckRun struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	HeadSHA     string     `json:"head_sha"`
+	ExternalID  string     `json:"external_id"`
+	Status      string     `json:"status"`     // "queued", "in_progress" or "completed"
+	Conclusion  string     `json:"conclusion"` // "success", "failure", ... once completed
+	URL         string     `json:"url"`
+	HTMLURL     string     `json:"html_url"`
+	DetailsURL  string     `json:"details_url"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"` // nil until completed
+	Output      struct {
+		Title            string `json:"title"`
+		Summary          string `json:"summary"`
+		Text             string `json:"text"`
+		AnnotationsCount int    `json:"annotations_count"`
+	} `json:"output"`
+	CheckSuite struct {
+		ID int64 `json:"id"`
+	} `json:"check_suite"`
+}
+
+// CheckRunRequest is the set of check run fields sent when creating or
+// updating a check run. Empty fields are left unchanged on update.
+type CheckRunRequest struct {
+	Name        string          `json:"name,omitempty"`
+	HeadSHA     string          `json:"head_sha,omitempty"` // required on create
+	DetailsURL  string          `json:"details_url,omitempty"`
+	ExternalID  string          `json:"external_id,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	Conclusion  string          `json:"conclusion,omitempty"` // required with status "completed"
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	Output      *CheckRunOutput `json:"output,omitempty"`
+}
+
+// CheckRunOutput is the report of a check run. Title and Summary are
+// required.
+type CheckRunOutput struct {
+	Title       string            `json:"title"`
+	Summary     string            `json:"summary"` // Markdown
+	Text        string            `json:"text,omitempty"`
+	Annotations []CheckAnnotation `json:"annotations,omitempty"`
+}
+
+// CheckAnnotation points out a problem at a line, or range of lines, of a
+// file.
+type CheckAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	StartColumn     int    `json:"start_column,omitempty"` // only on a single line
+	EndColumn       int    `json:"end_column,omitempty"`
+	AnnotationLevel string `json:"annotation_level"` // "notice", "warning" or "failure"
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+	RawDetails      string `json:"raw_details,omitempty"`
+}
+
+// maxAnnotations is the number of annotations the API accepts per request.
+const maxAnnotations = 50
+
+// LoadCheckRuns loads the check runs for the ref, which may be a commit
+// SHA, branch or tag name.
+func (c *Client) LoadCheckRuns(repo, ref string) ([]CheckRun, error) {
+	link := c.apiURL("repos", repo, "commits", escapePath(ref), "check-runs")
+	runs, err := c.loadSliceField(link, "check_runs", CheckRun{})
+	if err != nil {
+		return nil, err
+	}
+	return runs.([]CheckRun), nil
+}
+
+// LoadCheckRuns is a wrapper around DefaultClient.LoadCheckRuns.
+func LoadCheckRuns(repo, ref string) ([]CheckRun, error) {
+	return DefaultClient.LoadCheckRuns(repo, ref)
+}
+
+// CreateCheckRun creates a check run, which requires authenticating as a
+// GitHub App. Annotations beyond the fifty the API accepts at a time are
+// added with further updates.
+func (c *Client) CreateCheckRun(repo string, run CheckRunRequest) (CheckRun, error) {
+	link := c.apiURL("repos", repo, "check-runs")
+	return c.sendCheckRun("POST", link, run)
+}
+
+// CreateCheckRun is a wrapper around DefaultClient.CreateCheckRun.
+func CreateCheckRun(repo string, run CheckRunRequest) (CheckRun, error) {
+	return DefaultClient.CreateCheckRun(repo, run)
+}
+
+// UpdateCheckRun updates the check run. Annotations are added to those
+// already on the run, fifty at a time.
+func (c *Client) UpdateCheckRun(repo string, runID int64, run CheckRunRequest) (CheckRun, error) {
+	link := c.apiURL("repos", repo, "check-runs", strconv.FormatInt(runID, 10))
+	return c.sendCheckRun("PATCH", link, run)
+}
+
+// UpdateCheckRun is a wrapper around DefaultClient.UpdateCheckRun.
+func UpdateCheckRun(repo string, runID int64, run CheckRunRequest) (CheckRun, error) {
+	return DefaultClient.UpdateCheckRun(repo, runID, run)
+}
+
+// sendCheckRun creates or updates a check run with the first batch of
+// annotations, then adds the rest with updates.
+func (c *Client) sendCheckRun(method, link string, run CheckRunRequest) (CheckRun, error) {
+	var rest []CheckAnnotation
+	if run.Output != nil && len(run.Output.Annotations) > maxAnnotations {
+		out := *run.Output
+		rest = out.Annotations[maxAnnotations:]
+		out.Annotations = out.Annotations[:maxAnnotations]
+		run.Output = &out
+	}
+
+	var res CheckRun
+	if err := c.request(method, link, run, &res); err != nil {
+		return CheckRun{}, err
+	}
+
+	for len(rest) > 0 {
+		batch := rest
+		if len(batch) > maxAnnotations {
+			batch = batch[:maxAnnotations]
+		}
+		rest = rest[len(batch):]
+
+		update := CheckRunRequest{Output: &CheckRunOutput{
+			Title:       run.Output.Title,
+			Summary:     run.Output.Summary,
+			Text:        run.Output.Text,
+			Annotations: batch,
+		}}
+		if err := c.request("PATCH", res.URL, update, &res); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
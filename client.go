@@ -0,0 +1,328 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the root of the GitHub REST API.
+const DefaultBaseURL = "https://api.github.com/"
+
+// Client is a GitHub API client. Its methods correspond to the package
+// level functions, which use DefaultClient. A Client is safe for
+// concurrent use, as long as its fields are not changed.
+type Client struct {
+	// HTTPClient sends the requests. Its transport should normally be a
+	// *Transport, for conditional requests and rate limit tracking. An
+	// http.Client using DefaultTransport is used if nil.
+	HTTPClient *http.Client
+
+	// Tokens supplies the token requests are authenticated with. Requests
+	// are unauthenticated if nil.
+	Tokens TokenSource
+
+	// Username, if set, sends the token with HTTP basic authentication
+	// as the given user instead of as a token header.
+	Username string
+
+	// BaseURL is the root of the REST API. DefaultBaseURL is used if
+	// empty. For GitHub Enterprise Server it is the /api/v3/ path on the
+	// server; see NewEnterpriseClient.
+	BaseURL string
+
+	// MaxRateLimitWait is the longest the client waits for the rate limit
+	// to reset when it has run out of requests, before retrying the
+	// request. Requests fail with an error matching ErrRateLimited when
+	// the reset is further away, or if MaxRateLimitWait is zero.
+	MaxRateLimitWait time.Duration
+
+	// MaxRetries is the number of times a request refused by a secondary
+	// rate limit is retried, after the delay GitHub asks for or with
+	// exponential backoff starting at one minute.
+	MaxRetries int
+
+	// UserCache, if set, caches the users loaded by LoadUsersBatch, such
+	// as in a MemoryCache, which bounds its size. Entries are kept apart
+	// per base URL and credentials, so a cache may be shared by clients.
+	UserCache Cache
+
+	// CommitEmailFallback makes GetUserEmail look at the user's commits
+	// in their recent public push events when the user has no public
+	// profile email, at the cost of a few more requests.
+	CommitEmailFallback bool
+
+	// RetainRawJSON makes loaded objects keep the JSON they were decoded
+	// from in their Raw field, giving access to fields this package does
+	// not model. It is off by default, as it roughly doubles the memory
+	// used by loaded objects.
+	RetainRawJSON bool
+
+	ctx context.Context
+}
+
+// DefaultClient is the Client used by the package level functions. It
+// authenticates with the token in the GITHUB_TOKEN or GH_TOKEN environment
+// variable, as the user in GITHUB_USERNAME if that is set. Set its Tokens
+// to ChainTokens(EnvTokens(...), ConfigTokens("github.com")) to also look
+// in the gh and hub configurations and ~/.netrc.
+var DefaultClient = &Client{
+	Tokens:   EnvTokens("GITHUB_TOKEN", "GH_TOKEN"),
+	Username: os.Getenv("GITHUB_USERNAME"),
+}
+
+var defaultHTTPClient = &http.Client{Transport: DefaultTransport}
+
+// NewEnterpriseClient returns a client for the GitHub Enterprise Server at
+// the given URL, which may be either the server root
+// ("https://github.example.com") or the API root
+// ("https://github.example.com/api/v3").
+func NewEnterpriseClient(serverURL string) (*Client, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid server URL %q", serverURL)
+	}
+	p := strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(p, "/api/v3") {
+		p += "/api/v3"
+	}
+	u.Path = p + "/"
+	return &Client{BaseURL: u.String()}, nil
+}
+
+// apiHosts are the hosts that receive credentials, in addition to the
+// host of the base URL. Other hosts, such as the storage hosts that asset
+// downloads are redirected to, do not.
+var apiHosts = map[string]bool{
+	"api.github.com":     true,
+	"uploads.github.com": true,
+}
+
+// StaticToken returns a TokenSource that always returns the given token.
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+type staticToken string
+
+func (t staticToken) Token() (string, error) {
+	return string(t), nil
+}
+
+// WithContext returns a copy of the client that makes its requests with
+// the given context. Cancelling the context aborts requests in progress,
+// including paginated loads between pages.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// Context returns the client's context, or context.Background if it has
+// none.
+func (c *Client) Context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+// sleep waits for the given duration, returning early with an error if
+// the client's context is cancelled.
+func (c *Client) sleep(d time.Duration) error {
+	if d <= 0 {
+		return c.Context().Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-c.Context().Done():
+		return c.Context().Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// newRequest returns a request with the client's context.
+func (c *Client) newRequest(method, link string, body io.Reader) (*http.Request, error) {
+	return http.NewRequestWithContext(c.Context(), method, link, body)
+}
+
+// Do sends a hand written API request, authenticated with the client's
+// credentials. As with http.Client.Do, unsuccessful responses are not
+// errors and the caller must close the response body.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+	for retries := 0; ; retries++ {
+		resp, err := c.httpClient().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		wait, ok := c.retryWait(resp, retries)
+		if !ok || !canRetry(req) {
+			return resp, nil
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		if err := c.sleep(wait); err != nil {
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Rate returns the most recently seen rate limit status for the given
+// resource, if the client's transport is a *Transport.
+func (c *Client) Rate(resource string) (Rate, bool) {
+	t, ok := c.httpClient().Transport.(*Transport)
+	if !ok {
+		return Rate{}, false
+	}
+	return t.Rate(resource)
+}
+
+// authenticate adds credentials to requests for the API, unless the
+// request already carries an Authorization header.
+func (c *Client) authenticate(req *http.Request) error {
+	if c.Tokens == nil || req.Header.Get("Authorization") != "" || !c.isAPIHost(req.URL.Host) {
+		return nil
+	}
+	token, err := c.Tokens.Token()
+	if err != nil {
+		return err
+	}
+	switch {
+	case token == "":
+	case c.Username != "":
+		req.SetBasicAuth(c.Username, token)
+	default:
+		req.Header.Set("Authorization", "token "+token)
+	}
+	return nil
+}
+
+func (c *Client) isAPIHost(host string) bool {
+	if apiHosts[host] {
+		return true
+	}
+	u, err := url.Parse(c.baseURL())
+	return err == nil && strings.EqualFold(u.Host, host)
+}
+
+// apiURL returns the URL of the API path made up of the given elements.
+func (c *Client) apiURL(elem ...string) string {
+	return strings.TrimSuffix(c.baseURL(), "/") + "/" + joinPath(elem...)
+}
+
+// joinPath joins the already escaped elements with slashes. Unlike
+// path.Join it does not resolve dot segments, which are instead escaped so
+// that an element can never address a parent endpoint. Empty segments are
+// dropped.
+func joinPath(elem ...string) string {
+	var segs []string
+	for _, e := range elem {
+		for _, s := range strings.Split(e, "/") {
+			if s != "" {
+				segs = append(segs, escapeDots(s))
+			}
+		}
+	}
+	return strings.Join(segs, "/")
+}
+
+// escapeDots escapes the dot segments "." and "..", leaving other
+// segments as they are.
+func escapeDots(seg string) string {
+	if seg == "." || seg == ".." {
+		return strings.Repeat("%2E", len(seg))
+	}
+	return seg
+}
+
+// escapePath escapes each segment of a slash separated path, such as a
+// file path or a ref, for use as an apiURL element. Dot segments are
+// escaped rather than resolved.
+func escapePath(p string) string {
+	segs := strings.Split(p, "/")
+	for i, s := range segs {
+		segs[i] = escapeDots(url.PathEscape(s))
+	}
+	return strings.Join(segs, "/")
+}
+
+// pathSegment escapes a name, such as a label name or tag, for use as a
+// single apiURL element. Empty names and the dot segments "." and "..",
+// which would address another endpoint, are refused.
+func pathSegment(kind, name string) (string, error) {
+	if name == "" || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid %s %q", kind, name)
+	}
+	return url.PathEscape(name), nil
+}
+
+// graphQLURL returns the GraphQL endpoint belonging to the REST API at the
+// base URL, which on GitHub Enterprise Server is /api/graphql.
+func (c *Client) graphQLURL() string {
+	base := strings.TrimSuffix(c.baseURL(), "/")
+	if strings.HasSuffix(base, "/api/v3") {
+		return strings.TrimSuffix(base, "/v3") + "/graphql"
+	}
+	return base + "/graphql"
+}
+
+// uploadURL returns the URL of the upload API path made up of the given
+// elements. On GitHub Enterprise Server the upload API is at
+// /api/uploads.
+func (c *Client) uploadURL(elem ...string) string {
+	base := strings.TrimSuffix(c.baseURL(), "/")
+	switch {
+	case strings.HasSuffix(base, "/api/v3"):
+		base = strings.TrimSuffix(base, "/v3") + "/uploads"
+	case base+"/" == DefaultBaseURL:
+		base = "https://uploads.github.com"
+	}
+	return base + "/" + joinPath(elem...)
+}
+
+// webURL returns the URL of the given path on the web site belonging to
+// the API at the base URL, such as https://github.com for the public API.
+func (c *Client) webURL(elem ...string) string {
+	base := strings.TrimSuffix(c.baseURL(), "/")
+	switch {
+	case strings.HasSuffix(base, "/api/v3"):
+		base = strings.TrimSuffix(base, "/api/v3")
+	case base+"/" == DefaultBaseURL:
+		base = "https://github.com"
+	}
+	return base + "/" + joinPath(elem...)
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return DefaultBaseURL
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return defaultHTTPClient
+}
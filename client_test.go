@@ -0,0 +1,70 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIURLDotSegments(t *testing.T) {
+	c := &Client{BaseURL: "https://api.example.com/"}
+	cases := []struct {
+		elem []string
+		want string
+	}{
+		{[]string{"repos", "o/r", "contents", escapePath("a/../../..")}, "https://api.example.com/repos/o/r/contents/a/%2E%2E/%2E%2E/%2E%2E"},
+		{[]string{"repos", "o/r", "git/refs", escapePath("heads/./x")}, "https://api.example.com/repos/o/r/git/refs/heads/%2E/x"},
+		{[]string{"repos", "o/r", "contents", escapePath("/dir/")}, "https://api.example.com/repos/o/r/contents/dir"},
+		{[]string{"repos", "o/..", "issues"}, "https://api.example.com/repos/o/%2E%2E/issues"},
+	}
+	for _, tc := range cases {
+		if got := c.apiURL(tc.elem...); got != tc.want {
+			t.Errorf("apiURL(%q) = %q, want %q", tc.elem, got, tc.want)
+		}
+	}
+}
+
+func TestPathTraversalKeepsEndpoint(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.Method+" "+req.URL.EscapedPath())
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+	c := &Client{BaseURL: srv.URL}
+
+	const traversal = "a/../../.."
+	c.DeleteFile("o/r", traversal, FileChange{})
+	c.GetContents("o/r", traversal, "")
+	c.DeleteRef("o/r", "heads/"+traversal)
+	c.LoadCommit("o/r", traversal)
+	c.LoadCheckRuns("o/r", traversal)
+	c.DownloadArchive("o/r", traversal, "tarball", &strings.Builder{})
+
+	want := []string{
+		"DELETE /repos/o/r/contents/a/%2E%2E/%2E%2E/%2E%2E",
+		"GET /repos/o/r/contents/a/%2E%2E/%2E%2E/%2E%2E",
+		"DELETE /repos/o/r/git/refs/heads/a/%2E%2E/%2E%2E/%2E%2E",
+		"GET /repos/o/r/commits/a/%2E%2E/%2E%2E/%2E%2E",
+		"GET /repos/o/r/commits/a/%2E%2E/%2E%2E/%2E%2E/check-runs",
+		"GET /repos/o/r/tarball/a/%2E%2E/%2E%2E/%2E%2E",
+	}
+	if len(paths) != len(want) {
+		t.Fatalf("got requests %q, want %q", paths, want)
+	}
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("request %d: got %q, want %q", i, paths[i], want[i])
+		}
+	}
+}
+
+func TestCompareDotRefs(t *testing.T) {
+	c := &Client{BaseURL: "http://127.0.0.1:1/"}
+	for _, refs := range [][2]string{{"..", "main"}, {"main", "."}, {"", "main"}} {
+		if _, err := c.CompareCommits("o/r", refs[0], refs[1]); err == nil || !strings.HasPrefix(err.Error(), "invalid") {
+			t.Errorf("CompareCommits(%q, %q): got error %v, want invalid ref", refs[0], refs[1], err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Comment struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	HTMLURL   string    `json:"html_url"`
+	Body      string    `json:"body"`
+	User      User      `json:"user"`
+	Reactions Reactions `json:"reactions"`
+	Created   time.Time `json:"created_at"`
+	Updated   time.Time `json:"updated_at"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+func (c Comment) BodyHTML() template.HTML {
+	return renderMarkdown(c.Body)
+}
+
+// LoadIssueComments loads the comments on an issue or pull request,
+// oldest first. The query takes the "since" parameter.
+func (c *Client) LoadIssueComments(repo string, number int, query url.Values) ([]Comment, error) {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "comments")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	comments, err := c.loadSlice(link, Comment{})
+	if err != nil {
+		return nil, err
+	}
+	return comments.([]Comment), nil
+}
+
+// LoadIssueComments is a wrapper around DefaultClient.LoadIssueComments.
+func LoadIssueComments(repo string, number int, query url.Values) ([]Comment, error) {
+	return DefaultClient.LoadIssueComments(repo, number, query)
+}
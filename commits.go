@@ -0,0 +1,91 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type Commit struct {
+	SHA       string `json:"sha"`
+	URL       string `json:"url"`
+	HTMLURL   string `json:"html_url"`
+	Author    User   `json:"author"`    // zero value if not linked to a GitHub user
+	Committer User   `json:"committer"` // zero value if not linked to a GitHub user
+	Commit    struct {
+		Message   string       `json:"message"`
+		Author    CommitAuthor `json:"author"`
+		Committer CommitAuthor `json:"committer"`
+	} `json:"commit"`
+	Parents []struct {
+		SHA string `json:"sha"`
+		URL string `json:"url"`
+	} `json:"parents"`
+
+	// Stats and Files are only present when loading a single commit.
+	Stats struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+		Total     int `json:"total"`
+	} `json:"stats"`
+	Files []CommitFile `json:"files"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+// CommitAuthor is the git author or committer of a commit.
+type CommitAuthor struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
+// CommitFile is a file changed by a commit.
+type CommitFile struct {
+	SHA              string `json:"sha"`
+	Filename         string `json:"filename"`
+	Status           string `json:"status"` // "added", "removed", "modified", "renamed", ...
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	Patch            string `json:"patch"`             // empty for binary or very large files
+	PreviousFilename string `json:"previous_filename"` // for renamed files
+	BlobURL          string `json:"blob_url"`
+	RawURL           string `json:"raw_url"`
+}
+
+// LoadCommits loads the commits of the repository, newest first. The
+// query may select a branch ("sha"), a "path", an "author" and a time
+// range ("since" and "until").
+func (c *Client) LoadCommits(repo string, query url.Values) ([]Commit, error) {
+	link := c.apiURL("repos", repo, "commits")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	commits, err := c.loadSlice(link, Commit{})
+	if err != nil {
+		return nil, err
+	}
+	return commits.([]Commit), nil
+}
+
+// LoadCommits is a wrapper around DefaultClient.LoadCommits.
+func LoadCommits(repo string, query url.Values) ([]Commit, error) {
+	return DefaultClient.LoadCommits(repo, query)
+}
+
+// LoadCommit loads the commit, which may be given as a SHA, branch or tag,
+// including its stats and changed files.
+func (c *Client) LoadCommit(repo, ref string) (Commit, error) {
+	link := c.apiURL("repos", repo, "commits", escapePath(ref))
+	var commit Commit
+	if err := c.requestInto(link, &commit); err != nil {
+		return Commit{}, err
+	}
+	return commit, nil
+}
+
+// LoadCommit is a wrapper around DefaultClient.LoadCommit.
+func LoadCommit(repo, ref string) (Commit, error) {
+	return DefaultClient.LoadCommit(repo, ref)
+}
@@ -0,0 +1,64 @@
+package github
+
+// Comparison is the difference between two commits.
+type Comparison struct {
+	Status       string       `json:"status"` // "ahead", "behind", "diverged" or "identical"
+	AheadBy      int          `json:"ahead_by"`
+	BehindBy     int          `json:"behind_by"`
+	TotalCommits int          `json:"total_commits"`
+	Commits      []Commit     `json:"commits"` // oldest first
+	Files        []CommitFile `json:"files"`   // at most 300
+	HTMLURL      string       `json:"html_url"`
+}
+
+// CompareCommits compares head to base, which may be branch names, tags or
+// commit SHAs. All commits are loaded, page by page, where the API would
+// otherwise return at most 250.
+func (c *Client) CompareCommits(repo, base, head string) (Comparison, error) {
+	baseSeg, err := pathSegment("base", base)
+	if err != nil {
+		return Comparison{}, err
+	}
+	headSeg, err := pathSegment("head", head)
+	if err != nil {
+		return Comparison{}, err
+	}
+	link := c.apiURL("repos", repo, "compare", baseSeg+"..."+headSeg) + "?per_page=100"
+	var cmp Comparison
+	for first := true; link != ""; first = false {
+		req, err := c.newRequest("GET", link, nil)
+		if err != nil {
+			return Comparison{}, err
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			return Comparison{}, err
+		}
+		if resp.StatusCode > 299 {
+			err := responseError(resp)
+			resp.Body.Close()
+			return Comparison{}, err
+		}
+
+		var page Comparison
+		err = c.decodeJSON(resp.Body, &page)
+		resp.Body.Close()
+		if err != nil {
+			return Comparison{}, err
+		}
+
+		// The files are only listed on the first page.
+		if first {
+			cmp = page
+		} else {
+			cmp.Commits = append(cmp.Commits, page.Commits...)
+		}
+		link = parseRel(resp.Header.Get("Link"), "next")
+	}
+	return cmp, nil
+}
+
+// CompareCommits is a wrapper around DefaultClient.CompareCommits.
+func CompareCommits(repo, base, head string) (Comparison, error) {
+	return DefaultClient.CompareCommits(repo, base, head)
+}
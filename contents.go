@@ -0,0 +1,226 @@
+package github
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Content is a file or directory in a repository.
+type Content struct {
+	Type        string `json:"type"` // "file", "dir", "symlink" or "submodule"
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	SHA         string `json:"sha"`
+	Size        int    `json:"size"`
+	URL         string `json:"url"`
+	HTMLURL     string `json:"html_url"`
+	DownloadURL string `json:"download_url"`
+
+	// Data is the decoded content of a file loaded with GetContents or
+	// GetReadme.
+	Data []byte `json:"-"`
+}
+
+// CommitIdentity is the author or committer of a commit made through the
+// API.
+type CommitIdentity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// FileChange describes a single file commit made with PutFile or
+// DeleteFile.
+type FileChange struct {
+	Message string
+	Content []byte // new file content, for PutFile
+	SHA     string // blob SHA of the file being replaced or deleted
+	Branch  string // the default branch if empty
+
+	// Author and Committer default to the authenticated user.
+	Author    *CommitIdentity
+	Committer *CommitIdentity
+}
+
+// ContentCommit is the result of a file commit.
+type ContentCommit struct {
+	Content *Content `json:"content"` // nil after a delete
+	Commit  struct {
+		SHA     string       `json:"sha"`
+		Message string       `json:"message"`
+		HTMLURL string       `json:"html_url"`
+		Author  CommitAuthor `json:"author"`
+	} `json:"commit"`
+}
+
+type fileChangeRequest struct {
+	Message   string          `json:"message"`
+	SHA       string          `json:"sha,omitempty"`
+	Branch    string          `json:"branch,omitempty"`
+	Author    *CommitIdentity `json:"author,omitempty"`
+	Committer *CommitIdentity `json:"committer,omitempty"`
+}
+
+func (c FileChange) request() fileChangeRequest {
+	return fileChangeRequest{
+		Message:   c.Message,
+		SHA:       c.SHA,
+		Branch:    c.Branch,
+		Author:    c.Author,
+		Committer: c.Committer,
+	}
+}
+
+// PutFile creates or replaces the file at path with a single commit.
+// Replacing an existing file requires its current blob SHA.
+func (c *Client) PutFile(repo, filePath string, change FileChange) (ContentCommit, error) {
+	link := c.apiURL("repos", repo, "contents", escapePath(filePath))
+	req := struct {
+		fileChangeRequest
+		Content string `json:"content"`
+	}{change.request(), base64.StdEncoding.EncodeToString(change.Content)}
+	var res ContentCommit
+	if err := c.request("PUT", link, req, &res); err != nil {
+		return ContentCommit{}, err
+	}
+	return res, nil
+}
+
+// PutFile is a wrapper around DefaultClient.PutFile.
+func PutFile(repo, filePath string, change FileChange) (ContentCommit, error) {
+	return DefaultClient.PutFile(repo, filePath, change)
+}
+
+// DeleteFile deletes the file at path with a single commit. The change
+// must have the file's current blob SHA.
+func (c *Client) DeleteFile(repo, filePath string, change FileChange) (ContentCommit, error) {
+	link := c.apiURL("repos", repo, "contents", escapePath(filePath))
+	var res ContentCommit
+	if err := c.request("DELETE", link, change.request(), &res); err != nil {
+		return ContentCommit{}, err
+	}
+	return res, nil
+}
+
+// DeleteFile is a wrapper around DefaultClient.DeleteFile.
+func DeleteFile(repo, filePath string, change FileChange) (ContentCommit, error) {
+	return DefaultClient.DeleteFile(repo, filePath, change)
+}
+
+// EditFile commits a change to an existing file. The edit function is
+// given the current content on the change's branch and returns the new
+// content; the blob SHA of the file is filled in, so that the commit fails
+// if the file changed in between. Nothing is committed if the content is
+// unchanged.
+func (c *Client) EditFile(repo, filePath string, change FileChange, edit func([]byte) ([]byte, error)) (ContentCommit, error) {
+	cur, _, err := c.GetContents(repo, filePath, change.Branch)
+	if err != nil {
+		return ContentCommit{}, err
+	}
+	if cur.Type != "file" {
+		return ContentCommit{}, fmt.Errorf("%s: not a file", filePath)
+	}
+	data, err := edit(cur.Data)
+	if err != nil {
+		return ContentCommit{}, err
+	}
+	if bytes.Equal(data, cur.Data) {
+		return ContentCommit{Content: &cur}, nil
+	}
+	change.Content = data
+	change.SHA = cur.SHA
+	return c.PutFile(repo, filePath, change)
+}
+
+// EditFile is a wrapper around DefaultClient.EditFile.
+func EditFile(repo, filePath string, change FileChange, edit func([]byte) ([]byte, error)) (ContentCommit, error) {
+	return DefaultClient.EditFile(repo, filePath, change, edit)
+}
+
+// GetContents loads the file or directory at the path, on the given
+// branch, tag or commit, or on the default branch if ref is empty. For a
+// file the content is returned, with its data decoded; for a directory the
+// entries are returned, without data.
+func (c *Client) GetContents(repo, filePath, ref string) (Content, []Content, error) {
+	link := contentsLink(c.apiURL("repos", repo, "contents", escapePath(filePath)), ref)
+	var buf bytes.Buffer
+	if _, err := c.download(link, &buf); err != nil {
+		return Content{}, nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(buf.Bytes()), []byte("[")) {
+		var entries []Content
+		if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+			return Content{}, nil, err
+		}
+		return Content{}, entries, nil
+	}
+
+	file, err := c.decodeContent(link, buf.Bytes())
+	return file, nil, err
+}
+
+// GetContents is a wrapper around DefaultClient.GetContents.
+func GetContents(repo, filePath, ref string) (Content, []Content, error) {
+	return DefaultClient.GetContents(repo, filePath, ref)
+}
+
+// GetReadme loads the README of the repository, on the given branch, tag
+// or commit, or on the default branch if ref is empty.
+func (c *Client) GetReadme(repo, ref string) (Content, error) {
+	link := contentsLink(c.apiURL("repos", repo, "readme"), ref)
+	var buf bytes.Buffer
+	if _, err := c.download(link, &buf); err != nil {
+		return Content{}, err
+	}
+	return c.decodeContent(link, buf.Bytes())
+}
+
+// GetReadme is a wrapper around DefaultClient.GetReadme.
+func GetReadme(repo, ref string) (Content, error) {
+	return DefaultClient.GetReadme(repo, ref)
+}
+
+func contentsLink(link, ref string) string {
+	if ref != "" {
+		link += "?ref=" + url.QueryEscape(ref)
+	}
+	return link
+}
+
+// decodeContent decodes a file content response from link. Files larger
+// than one megabyte are returned without content, which is then loaded
+// again in raw form.
+func (c *Client) decodeContent(link string, data []byte) (Content, error) {
+	var res struct {
+		Content
+		Encoded  string `json:"content"`
+		Encoding string `json:"encoding"` // "base64", or "none" for large files
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return Content{}, err
+	}
+
+	file := res.Content
+	switch res.Encoding {
+	case "base64":
+		// The encoded content is split into lines.
+		bs, err := base64.StdEncoding.DecodeString(strings.Replace(res.Encoded, "\n", "", -1))
+		if err != nil {
+			return Content{}, err
+		}
+		file.Data = bs
+	case "none":
+		var buf bytes.Buffer
+		if _, err := c.download(link, &buf, withAccept("application/vnd.github.raw")); err != nil {
+			return Content{}, err
+		}
+		file.Data = buf.Bytes()
+	default:
+		file.Data = []byte(res.Encoded)
+	}
+	return file, nil
+}
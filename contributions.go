@@ -0,0 +1,77 @@
+package github
+
+import (
+	"time"
+)
+
+type ContributionCalendar struct {
+	Total int
+	Days  []ContributionDay
+}
+
+type ContributionDay struct {
+	Date  time.Time
+	Count int
+}
+
+const contributionCalendarQuery = `query($login: String!, $from: DateTime!, $to: DateTime!) {
+  user(login: $login) {
+    contributionsCollection(from: $from, to: $to) {
+      contributionCalendar {
+        totalContributions
+        weeks {
+          contributionDays {
+            date
+            contributionCount
+          }
+        }
+      }
+    }
+  }
+}`
+
+// LoadContributionCalendar loads the user's per day contribution counts
+// between from and to, which may be at most a year apart.
+func (c *Client) LoadContributionCalendar(username string, from, to time.Time) (ContributionCalendar, error) {
+	var res struct {
+		User struct {
+			ContributionsCollection struct {
+				ContributionCalendar struct {
+					TotalContributions int
+					Weeks              []struct {
+						ContributionDays []struct {
+							Date              string
+							ContributionCount int
+						}
+					}
+				}
+			}
+		}
+	}
+	vars := map[string]interface{}{
+		"login": username,
+		"from":  from.UTC().Format(time.RFC3339),
+		"to":    to.UTC().Format(time.RFC3339),
+	}
+	if err := c.Query(contributionCalendarQuery, vars, &res); err != nil {
+		return ContributionCalendar{}, err
+	}
+
+	cal := res.User.ContributionsCollection.ContributionCalendar
+	result := ContributionCalendar{Total: cal.TotalContributions}
+	for _, week := range cal.Weeks {
+		for _, day := range week.ContributionDays {
+			date, err := time.Parse("2006-01-02", day.Date)
+			if err != nil {
+				return ContributionCalendar{}, err
+			}
+			result.Days = append(result.Days, ContributionDay{Date: date, Count: day.ContributionCount})
+		}
+	}
+	return result, nil
+}
+
+// LoadContributionCalendar is a wrapper around DefaultClient.LoadContributionCalendar.
+func LoadContributionCalendar(username string, from, to time.Time) (ContributionCalendar, error) {
+	return DefaultClient.LoadContributionCalendar(username, from, to)
+}
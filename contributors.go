@@ -0,0 +1,172 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// ContributorReport summarizes the activity in a repository during a
+// period, per contributor.
+type ContributorReport struct {
+	Repo         string
+	From         time.Time
+	To           time.Time
+	Contributors []ContributorStats // most active first
+}
+
+type ContributorStats struct {
+	Login        string // or the git author name for commits not linked to a user
+	Commits      int
+	MergedPRs    int // authored pull requests merged during the period
+	IssuesOpened int
+	IssuesClosed int // issues the contributor closed
+	Reviews      int // reviews submitted during the period
+}
+
+func (s ContributorStats) total() int {
+	return s.Commits + s.MergedPRs + s.IssuesOpened + s.IssuesClosed + s.Reviews
+}
+
+// LoadContributorReport loads commits, issues, pull requests and reviews
+// for the repository and summarizes them per contributor for the period
+// [from, to).
+func (c *Client) LoadContributorReport(repo string, from, to time.Time) (ContributorReport, error) {
+	stats := make(map[string]*ContributorStats)
+	get := func(login string) *ContributorStats {
+		s, ok := stats[login]
+		if !ok {
+			s = &ContributorStats{Login: login}
+			stats[login] = s
+		}
+		return s
+	}
+	within := func(t *time.Time) bool {
+		return t != nil && !t.Before(from) && t.Before(to)
+	}
+
+	q := url.Values{
+		"since": {from.UTC().Format(time.RFC3339)},
+		"until": {to.UTC().Format(time.RFC3339)},
+	}
+	commits, err := c.LoadCommits(repo, q)
+	if err != nil {
+		return ContributorReport{}, err
+	}
+	for _, commit := range commits {
+		login := commit.Author.Login
+		if login == "" {
+			login = commit.Commit.Author.Name
+		}
+		get(login).Commits++
+	}
+
+	issues, err := c.loadIssuesActiveIn(repo, from, to)
+	if err != nil {
+		return ContributorReport{}, err
+	}
+	for _, i := range issues {
+		if i.Type() == "PR" {
+			if within(i.PullRequest.MergedAt) {
+				get(i.User.Login).MergedPRs++
+			}
+			if i.Closed != nil && i.Closed.Before(from) {
+				continue
+			}
+			reviews, err := c.LoadReviews(repo, i.Number)
+			if err != nil {
+				return ContributorReport{}, err
+			}
+			for _, r := range reviews {
+				if within(&r.Submitted) {
+					get(r.User.Login).Reviews++
+				}
+			}
+			continue
+		}
+
+		if within(&i.Created) {
+			get(i.User.Login).IssuesOpened++
+		}
+		if within(i.Closed) {
+			// The issue list doesn't say who closed the issue; the
+			// last close event within the period does.
+			events, err := c.LoadIssueEvents(repo, i.Number)
+			if err != nil {
+				return ContributorReport{}, err
+			}
+			for j := len(events) - 1; j >= 0; j-- {
+				if events[j].Event == "closed" && within(&events[j].Created) {
+					get(events[j].Actor.Login).IssuesClosed++
+					break
+				}
+			}
+		}
+	}
+
+	rep := ContributorReport{Repo: repo, From: from, To: to}
+	for _, s := range stats {
+		rep.Contributors = append(rep.Contributors, *s)
+	}
+	sort.Slice(rep.Contributors, func(a, b int) bool {
+		ta, tb := rep.Contributors[a].total(), rep.Contributors[b].total()
+		if ta != tb {
+			return ta > tb
+		}
+		return rep.Contributors[a].Login < rep.Contributors[b].Login
+	})
+	return rep, nil
+}
+
+// loadIssuesActiveIn loads the issues and pull requests that may have been
+// opened, closed, merged or reviewed in [from, to): those updated since
+// from and created before to.
+func (c *Client) loadIssuesActiveIn(repo string, from, to time.Time) ([]Issue, error) {
+	q := url.Values{
+		"state":     {"all"},
+		"since":     {from.UTC().Format(time.RFC3339)},
+		"sort":      {"created"},
+		"direction": {"asc"},
+		"per_page":  {"100"},
+	}
+	link := c.apiURL("repos", repo, "issues") + "?" + q.Encode()
+
+	var issues []Issue
+	for link != "" {
+		var page []Issue
+		next, err := c.loadPage(link, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range page {
+			if !i.Created.Before(to) {
+				return issues, nil
+			}
+			issues = append(issues, i)
+		}
+		link = next
+	}
+	return issues, nil
+}
+
+// LoadContributorReport is a wrapper around DefaultClient.LoadContributorReport.
+func LoadContributorReport(repo string, from, to time.Time) (ContributorReport, error) {
+	return DefaultClient.LoadContributorReport(repo, from, to)
+}
+
+// WriteMarkdown writes the report as a Markdown table.
+func (r ContributorReport) WriteMarkdown(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "## %s, %s to %s\n\n", r.Repo, r.From.Format("2006-01-02"), r.To.Format("2006-01-02")); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "| Contributor | Commits | Merged PRs | Reviews | Issues opened | Issues closed |")
+	fmt.Fprintln(w, "|---|--:|--:|--:|--:|--:|")
+	for _, c := range r.Contributors {
+		if _, err := fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %d |\n", c.Login, c.Commits, c.MergedPRs, c.Reviews, c.IssuesOpened, c.IssuesClosed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ChainTokens returns a TokenSource that returns the first non-empty
+// token from the given sources, tried in order. An error from a source is
+// returned immediately.
+func ChainTokens(sources ...TokenSource) TokenSource {
+	return chainTokens(sources)
+}
+
+type chainTokens []TokenSource
+
+func (c chainTokens) Token() (string, error) {
+	for _, src := range c {
+		tok, err := src.Token()
+		if err != nil || tok != "" {
+			return tok, err
+		}
+	}
+	return "", nil
+}
+
+// EnvTokens returns a TokenSource that returns the value of the first of
+// the given environment variables that is set. The environment is read for
+// each request, so the variables may be set after the package is
+// initialized.
+func EnvTokens(names ...string) TokenSource {
+	return envTokens(names)
+}
+
+type envTokens []string
+
+func (e envTokens) Token() (string, error) {
+	for _, name := range e {
+		if tok := os.Getenv(name); tok != "" {
+			return tok, nil
+		}
+	}
+	return "", nil
+}
+
+// ConfigTokens returns a TokenSource that looks for the token for the
+// host in the GitHub CLI (gh) configuration, the hub configuration and
+// ~/.netrc, in that order. It is not used by default, as reading other
+// programs' credentials should be a decision of the program using this
+// package.
+func ConfigTokens(host string) TokenSource {
+	return ChainTokens(GHConfigTokens(host), HubConfigTokens(host), NetrcTokens(host))
+}
+
+// GHConfigTokens returns a TokenSource that reads the token for the host
+// ("github.com" or an Enterprise Server host name) from the hosts.yml of
+// the GitHub CLI, in $GH_CONFIG_DIR, $XDG_CONFIG_HOME/gh or ~/.config/gh.
+// Tokens that gh keeps in the system keyring are not found. The file is
+// read once, on first use.
+func GHConfigTokens(host string) TokenSource {
+	return &fileTokens{
+		path: func() string {
+			if dir := os.Getenv("GH_CONFIG_DIR"); dir != "" {
+				return filepath.Join(dir, "hosts.yml")
+			}
+			if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+				return filepath.Join(dir, "gh", "hosts.yml")
+			}
+			return homePath(".config", "gh", "hosts.yml")
+		},
+		parse: func(data []byte) string { return yamlHostToken(data, host) },
+	}
+}
+
+// HubConfigTokens returns a TokenSource that reads the token for the host
+// from the hub configuration, in $HUB_CONFIG or ~/.config/hub. The file is
+// read once, on first use.
+func HubConfigTokens(host string) TokenSource {
+	return &fileTokens{
+		path: func() string {
+			if file := os.Getenv("HUB_CONFIG"); file != "" {
+				return file
+			}
+			return homePath(".config", "hub")
+		},
+		parse: func(data []byte) string { return yamlHostToken(data, host) },
+	}
+}
+
+// NetrcTokens returns a TokenSource that reads the token for the host, or
+// for its "api." subdomain, as the password in $NETRC or ~/.netrc. The
+// file is read once, on first use.
+func NetrcTokens(host string) TokenSource {
+	return &fileTokens{
+		path: func() string {
+			if file := os.Getenv("NETRC"); file != "" {
+				return file
+			}
+			return homePath(".netrc")
+		},
+		parse: func(data []byte) string { return netrcToken(data, host) },
+	}
+}
+
+// fileTokens returns the token parsed from a configuration file. A missing
+// file gives an empty token.
+type fileTokens struct {
+	path  func() string
+	parse func([]byte) string
+
+	once sync.Once
+	tok  string
+	err  error
+}
+
+func (f *fileTokens) Token() (string, error) {
+	f.once.Do(func() {
+		p := f.path()
+		if p == "" {
+			return
+		}
+		data, err := ioutil.ReadFile(p)
+		if os.IsNotExist(err) {
+			return
+		}
+		if err != nil {
+			f.err = err
+			return
+		}
+		f.tok = f.parse(data)
+	})
+	return f.tok, f.err
+}
+
+func homePath(elem ...string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(append([]string{home}, elem...)...)
+}
+
+// yamlHostToken returns the first oauth_token in the section for the host
+// in a gh or hub configuration file. These are simple enough that a full
+// YAML parser is not needed:
+//
+//	github.com:
+//	    user: jdoe
+//	    oauth_token: gho_...
+func yamlHostToken(data []byte, host string) string {
+	inHost := false
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := sc.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if line[0] != ' ' && line[0] != '\t' && line[0] != '-' {
+			// A top level key starts a new section.
+			inHost = strings.EqualFold(unquote(strings.TrimSuffix(trimmed, ":")), host)
+			continue
+		}
+		if !inHost {
+			continue
+		}
+		trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))
+		if strings.HasPrefix(trimmed, "oauth_token:") {
+			return unquote(strings.TrimSpace(strings.TrimPrefix(trimmed, "oauth_token:")))
+		}
+	}
+	return ""
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// netrcToken returns the password of the first machine entry for the host
+// or its API subdomain.
+func netrcToken(data []byte, host string) string {
+	fields := strings.Fields(string(data))
+	match := false
+	for i := 0; i < len(fields); i++ {
+		switch fields[i] {
+		case "machine":
+			if i+1 < len(fields) {
+				i++
+				m := fields[i]
+				match = strings.EqualFold(m, host) || strings.EqualFold(m, "api."+host)
+			}
+		case "default":
+			match = false
+		case "password":
+			if i+1 < len(fields) {
+				i++
+				if match {
+					return fields[i]
+				}
+			}
+		}
+	}
+	return ""
+}
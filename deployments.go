@@ -0,0 +1,152 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Deployment is a request to deploy a ref of the repository to an
+// environment.
+type Deployment struct {
+	ID                    int64           `json:"id"`
+	URL                   string          `json:"url"`
+	SHA                   string          `json:"sha"`
+	Ref                   string          `json:"ref"`
+	Task                  string          `json:"task"` // "deploy" by default
+	Environment           string          `json:"environment"`
+	Description           string          `json:"description"`
+	Payload               json.RawMessage `json:"payload"`
+	TransientEnvironment  bool            `json:"transient_environment"`
+	ProductionEnvironment bool            `json:"production_environment"`
+	Creator               User            `json:"creator"`
+	Created               time.Time       `json:"created_at"`
+	Updated               time.Time       `json:"updated_at"`
+}
+
+// DeploymentRequest describes a deployment to create with
+// CreateDeployment.
+type DeploymentRequest struct {
+	Ref         string      `json:"ref"` // branch, tag or SHA to deploy
+	Task        string      `json:"task,omitempty"`
+	Environment string      `json:"environment,omitempty"` // "production" if empty
+	Description string      `json:"description,omitempty"`
+	Payload     interface{} `json:"payload,omitempty"` // encoded as JSON, for the deployment tooling
+
+	// AutoMerge merges the default branch into the ref first, if it is
+	// behind. Defaults to true.
+	AutoMerge *bool `json:"auto_merge,omitempty"`
+	// RequiredContexts are the status contexts that must pass before
+	// deploying. All contexts are verified when nil; an empty, non-nil
+	// slice skips the verification.
+	RequiredContexts []string `json:"-"`
+
+	TransientEnvironment  bool  `json:"transient_environment,omitempty"`
+	ProductionEnvironment *bool `json:"production_environment,omitempty"` // defaults to true for "production"
+}
+
+func (r DeploymentRequest) MarshalJSON() ([]byte, error) {
+	type request DeploymentRequest
+	v := struct {
+		request
+		RequiredContexts *[]string `json:"required_contexts,omitempty"`
+	}{request: request(r)}
+	if r.RequiredContexts != nil {
+		v.RequiredContexts = &r.RequiredContexts
+	}
+	return json.Marshal(v)
+}
+
+// DeploymentStatus is a state change of a deployment.
+type DeploymentStatus struct {
+	ID             int64     `json:"id"`
+	State          string    `json:"state"` // "error", "failure", "inactive", "in_progress", "queued", "pending" or "success"
+	Description    string    `json:"description"`
+	Environment    string    `json:"environment"`
+	LogURL         string    `json:"log_url"`
+	EnvironmentURL string    `json:"environment_url"`
+	Creator        User      `json:"creator"`
+	Created        time.Time `json:"created_at"`
+	Updated        time.Time `json:"updated_at"`
+}
+
+// DeploymentStatusRequest describes a deployment status to create with
+// CreateDeploymentStatus.
+type DeploymentStatusRequest struct {
+	State          string `json:"state"`
+	Description    string `json:"description,omitempty"`
+	LogURL         string `json:"log_url,omitempty"`
+	EnvironmentURL string `json:"environment_url,omitempty"`
+	Environment    string `json:"environment,omitempty"` // changes the deployment's environment
+	// AutoInactive marks earlier deployments to the same environment
+	// inactive on "success". Defaults to true.
+	AutoInactive *bool `json:"auto_inactive,omitempty"`
+}
+
+// LoadDeployments loads the deployments of the repository, newest first.
+// The query may filter by "sha", "ref", "task" and "environment".
+func (c *Client) LoadDeployments(repo string, query url.Values) ([]Deployment, error) {
+	link := c.apiURL("repos", repo, "deployments")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	deps, err := c.loadSlice(link, Deployment{})
+	if err != nil {
+		return nil, err
+	}
+	return deps.([]Deployment), nil
+}
+
+// LoadDeployments is a wrapper around DefaultClient.LoadDeployments.
+func LoadDeployments(repo string, query url.Values) ([]Deployment, error) {
+	return DefaultClient.LoadDeployments(repo, query)
+}
+
+// CreateDeployment creates a deployment. When the default branch is
+// merged into the ref first, GitHub answers with 202 Accepted and no
+// deployment; the zero Deployment is then returned.
+func (c *Client) CreateDeployment(repo string, dep DeploymentRequest) (Deployment, error) {
+	link := c.apiURL("repos", repo, "deployments")
+	var res Deployment
+	if err := c.request("POST", link, dep, &res); err != nil {
+		return Deployment{}, err
+	}
+	return res, nil
+}
+
+// CreateDeployment is a wrapper around DefaultClient.CreateDeployment.
+func CreateDeployment(repo string, dep DeploymentRequest) (Deployment, error) {
+	return DefaultClient.CreateDeployment(repo, dep)
+}
+
+// LoadDeploymentStatuses loads the statuses of the deployment, newest
+// first.
+func (c *Client) LoadDeploymentStatuses(repo string, deploymentID int64) ([]DeploymentStatus, error) {
+	link := c.apiURL("repos", repo, "deployments", strconv.FormatInt(deploymentID, 10), "statuses")
+	statuses, err := c.loadSlice(link, DeploymentStatus{})
+	if err != nil {
+		return nil, err
+	}
+	return statuses.([]DeploymentStatus), nil
+}
+
+// LoadDeploymentStatuses is a wrapper around DefaultClient.LoadDeploymentStatuses.
+func LoadDeploymentStatuses(repo string, deploymentID int64) ([]DeploymentStatus, error) {
+	return DefaultClient.LoadDeploymentStatuses(repo, deploymentID)
+}
+
+// CreateDeploymentStatus records a state change of the deployment.
+func (c *Client) CreateDeploymentStatus(repo string, deploymentID int64, status DeploymentStatusRequest) (DeploymentStatus, error) {
+	link := c.apiURL("repos", repo, "deployments", strconv.FormatInt(deploymentID, 10), "statuses")
+	var res DeploymentStatus
+	if err := c.request("POST", link, status, &res); err != nil {
+		return DeploymentStatus{}, err
+	}
+	return res, nil
+}
+
+// CreateDeploymentStatus is a wrapper around DefaultClient.CreateDeploymentStatus.
+func CreateDeploymentStatus(repo string, deploymentID int64, status DeploymentStatusRequest) (DeploymentStatus, error) {
+	return DefaultClient.CreateDeploymentStatus(repo, deploymentID, status)
+}
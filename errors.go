@@ -0,0 +1,135 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matched by *APIError with errors.Is, according to the
+// response status.
+var (
+	ErrUnauthorized = errors.New("unauthorized")      // 401
+	ErrForbidden    = errors.New("forbidden")         // 403
+	ErrNotFound     = errors.New("not found")         // 404
+	ErrValidation   = errors.New("validation failed") // 422
+	ErrRateLimited  = errors.New("rate limited")      // 403 or 429, out of requests
+)
+
+// APIError is an unsuccessful response from the API.
+type APIError struct {
+	StatusCode int
+	Status     string // "404 Not Found"
+
+	// Message and DocumentationURL are from the error document GitHub
+	// returns. Errors holds the details of validation failures.
+	Message          string
+	DocumentationURL string
+	Errors           []FieldError
+
+	// Body is the start of the raw response body.
+	Body []byte
+
+	// Rate is the rate limit status reported with the response, if any.
+	// RetryAfter is the delay requested by a secondary rate limit.
+	Rate       Rate
+	RetryAfter time.Duration
+
+	// Scopes and AcceptedScopes are the OAuth scopes granted to the token
+	// and accepted by the endpoint, when GitHub reports them.
+	Scopes         string
+	AcceptedScopes string
+}
+
+// FieldError describes why a field of a request failed validation.
+type FieldError struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field"`
+	Code     string `json:"code"` // "missing", "missing_field", "invalid", "already_exists", "unprocessable" or "custom"
+	Message  string `json:"message"`
+}
+
+func (e FieldError) String() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s.%s: %s", e.Resource, e.Field, e.Code)
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = strings.TrimSpace(string(e.Body))
+	}
+	s := e.Status + ": " + msg
+	for _, fe := range e.Errors {
+		s += "; " + fe.String()
+	}
+	if e.AcceptedScopes != "" && (e.StatusCode == http.StatusForbidden || e.StatusCode == http.StatusNotFound) {
+		s += fmt.Sprintf(" (token scopes: %q, accepted scopes: %q)", e.Scopes, e.AcceptedScopes)
+	}
+	return s
+}
+
+// Is returns true for the sentinel error corresponding to the response
+// status.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrValidation:
+		return e.StatusCode == http.StatusUnprocessableEntity
+	case ErrRateLimited:
+		return e.rateLimited()
+	}
+	return false
+}
+
+func (e *APIError) rateLimited() bool {
+	if e.StatusCode != http.StatusForbidden && e.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return e.Rate.Limit > 0 && e.Rate.Remaining == 0 || e.RetryAfter > 0 || isSecondaryLimit(e.Message)
+}
+
+// newAPIError returns an *APIError for the response with the given
+// (partial) body.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode:     resp.StatusCode,
+		Status:         resp.Status,
+		Body:           body,
+		Scopes:         resp.Header.Get("X-OAuth-Scopes"),
+		AcceptedScopes: resp.Header.Get("X-Accepted-OAuth-Scopes"),
+	}
+	e.Rate, _, _ = parseRate(resp.Header)
+	e.RetryAfter, _ = retryAfter(resp.Header)
+
+	var doc struct {
+		Message          string            `json:"message"`
+		DocumentationURL string            `json:"documentation_url"`
+		Errors           []json.RawMessage `json:"errors"`
+	}
+	if json.Unmarshal(body, &doc) != nil {
+		return e
+	}
+	e.Message = doc.Message
+	e.DocumentationURL = doc.DocumentationURL
+	for _, raw := range doc.Errors {
+		// Errors are usually objects, but some endpoints return plain
+		// strings.
+		var fe FieldError
+		if json.Unmarshal(raw, &fe) != nil {
+			json.Unmarshal(raw, &fe.Message)
+		}
+		e.Errors = append(e.Errors, fe)
+	}
+	return e
+}
@@ -0,0 +1,381 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Event struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"` // "PushEvent", "IssuesEvent", ...
+	Actor User   `json:"actor"`
+	Repo  struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"` // "owner/name"
+		URL  string `json:"url"`
+	} `json:"repo"`
+	Payload json.RawMessage `json:"payload"`
+	Public  bool            `json:"public"`
+	Created time.Time       `json:"created_at"`
+}
+
+// Payloads of the common event types, as returned by Event.ParsePayload.
+// They differ from the webhook payloads of the same events in leaving out
+// the repository and sender, which are in the Event itself.
+type (
+	PushPayload struct {
+		PushID       int64  `json:"push_id"`
+		Ref          string `json:"ref"` // "refs/heads/main"
+		Head         string `json:"head"`
+		Before       string `json:"before"`
+		Size         int    `json:"size"`          // number of commits pushed
+		DistinctSize int    `json:"distinct_size"` // of which new to the repository
+		Commits      []struct {
+			SHA      string         `json:"sha"`
+			Message  string         `json:"message"`
+			Author   CommitIdentity `json:"author"`
+			Distinct bool           `json:"distinct"`
+			URL      string         `json:"url"`
+		} `json:"commits"` // at most 20
+	}
+
+	IssuesPayload struct {
+		Action string `json:"action"` // "opened", "closed", "reopened", ...
+		Issue  Issue  `json:"issue"`
+	}
+
+	IssueCommentPayload struct {
+		Action  string  `json:"action"` // "created"
+		Issue   Issue   `json:"issue"`
+		Comment Comment `json:"comment"`
+	}
+
+	PullRequestPayload struct {
+		Action      string      `json:"action"` // "opened", "closed", "reopened", ...
+		Number      int         `json:"number"`
+		PullRequest PullRequest `json:"pull_request"`
+	}
+
+	ReleasePayload struct {
+		Action  string  `json:"action"` // "published"
+		Release Release `json:"release"`
+	}
+
+	// CreatePayload and DeletePayload are for branches, tags and, in
+	// the case of creation, the repository itself.
+	CreatePayload struct {
+		Ref          string `json:"ref"`      // empty for repositories
+		RefType      string `json:"ref_type"` // "branch", "tag" or "repository"
+		MasterBranch string `json:"master_branch"`
+		Description  string `json:"description"`
+	}
+
+	DeletePayload struct {
+		Ref     string `json:"ref"`
+		RefType string `json:"ref_type"` // "branch" or "tag"
+	}
+
+	ForkPayload struct {
+		Forkee Repository `json:"forkee"`
+	}
+
+	// WatchPayload is the payload of a WatchEvent, which despite its name
+	// is sent when a repository is starred.
+	WatchPayload struct {
+		Action string `json:"action"` // "started"
+	}
+)
+
+// ParsePayload decodes the payload into a pointer to the payload type for
+// the event type: *PushPayload for "PushEvent", *IssuesPayload for
+// "IssuesEvent", and so on. Payloads of other event types are returned as
+// json.RawMessage.
+func (e Event) ParsePayload() (interface{}, error) {
+	var v interface{}
+	switch e.Type {
+	case "PushEvent":
+		v = new(PushPayload)
+	case "IssuesEvent":
+		v = new(IssuesPayload)
+	case "IssueCommentEvent":
+		v = new(IssueCommentPayload)
+	case "PullRequestEvent":
+		v = new(PullRequestPayload)
+	case "ReleaseEvent":
+		v = new(ReleasePayload)
+	case "CreateEvent":
+		v = new(CreatePayload)
+	case "DeleteEvent":
+		v = new(DeletePayload)
+	case "ForkEvent":
+		v = new(ForkPayload)
+	case "WatchEvent":
+		v = new(WatchPayload)
+	default:
+		return e.Payload, nil
+	}
+	if err := json.Unmarshal(e.Payload, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// LoadRepoEvents loads the recent events in the repository, newest first.
+// The API keeps at most 300 events from the last 90 days.
+func (c *Client) LoadRepoEvents(repo string) ([]Event, error) {
+	return c.loadEvents(c.apiURL("repos", repo, "events"))
+}
+
+// LoadRepoEvents is a wrapper around DefaultClient.LoadRepoEvents.
+func LoadRepoEvents(repo string) ([]Event, error) {
+	return DefaultClient.LoadRepoEvents(repo)
+}
+
+// LoadOrgEvents loads the recent public events in the organization,
+// newest first.
+func (c *Client) LoadOrgEvents(org string) ([]Event, error) {
+	return c.loadEvents(c.apiURL("orgs", org, "events"))
+}
+
+// LoadOrgEvents is a wrapper around DefaultClient.LoadOrgEvents.
+func LoadOrgEvents(org string) ([]Event, error) {
+	return DefaultClient.LoadOrgEvents(org)
+}
+
+// LoadUserEvents loads the recent events performed by the user, newest
+// first. Private events are included when authenticated as the user.
+func (c *Client) LoadUserEvents(username string) ([]Event, error) {
+	return c.loadEvents(c.apiURL("users", username, "events"))
+}
+
+// LoadUserEvents is a wrapper around DefaultClient.LoadUserEvents.
+func LoadUserEvents(username string) ([]Event, error) {
+	return DefaultClient.LoadUserEvents(username)
+}
+
+func (c *Client) loadEvents(link string) ([]Event, error) {
+	events, err := c.loadSlice(link+"?per_page=100", Event{})
+	if err != nil {
+		return nil, err
+	}
+	return events.([]Event), nil
+}
+
+// ErrEventsMissed is returned by EventPoller.Poll when events happened
+// between polls that are no longer in the feed.
+var ErrEventsMissed = errors.New("events missed between polls")
+
+// defaultPollInterval is used until GitHub tells us otherwise.
+const defaultPollInterval = 60 * time.Second
+
+// EventPoller polls an event feed for new events, using conditional
+// requests that don't count against the rate limit when nothing has
+// happened, and at the pace GitHub asks for with the X-Poll-Interval
+// header.
+type EventPoller struct {
+	client   *Client
+	link     string
+	etag     string
+	seen     map[string]bool
+	interval time.Duration
+}
+
+// NewRepoEventPoller returns a poller for the events in the repository.
+func (c *Client) NewRepoEventPoller(repo string) *EventPoller {
+	return newEventPoller(c, c.apiURL("repos", repo, "events"))
+}
+
+// NewRepoEventPoller is a wrapper around DefaultClient.NewRepoEventPoller.
+func NewRepoEventPoller(repo string) *EventPoller {
+	return DefaultClient.NewRepoEventPoller(repo)
+}
+
+// NewOrgEventPoller returns a poller for the public events in the
+// organization.
+func (c *Client) NewOrgEventPoller(org string) *EventPoller {
+	return newEventPoller(c, c.apiURL("orgs", org, "events"))
+}
+
+// NewOrgEventPoller is a wrapper around DefaultClient.NewOrgEventPoller.
+func NewOrgEventPoller(org string) *EventPoller {
+	return DefaultClient.NewOrgEventPoller(org)
+}
+
+// NewUserEventPoller returns a poller for the events performed by the
+// user. Private events are included when authenticated as the user.
+func (c *Client) NewUserEventPoller(username string) *EventPoller {
+	return newEventPoller(c, c.apiURL("users", username, "events"))
+}
+
+// NewUserEventPoller is a wrapper around DefaultClient.NewUserEventPoller.
+func NewUserEventPoller(username string) *EventPoller {
+	return DefaultClient.NewUserEventPoller(username)
+}
+
+func newEventPoller(c *Client, link string) *EventPoller {
+	return &EventPoller{
+		client:   c,
+		link:     link + "?per_page=100",
+		seen:     make(map[string]bool),
+		interval: defaultPollInterval,
+	}
+}
+
+// Interval returns how long to wait before the next poll, as requested by
+// GitHub.
+func (p *EventPoller) Interval() time.Duration {
+	return p.interval
+}
+
+// Poll returns the events not returned by a previous poll, oldest first.
+// The first poll returns the most recent events in the feed. If events
+// were lost between polls because the feed only keeps the last 300, the
+// events that remain are returned along with ErrEventsMissed.
+func (p *EventPoller) Poll() ([]Event, error) {
+	return p.poll(p.client)
+}
+
+func (p *EventPoller) poll(c *Client) ([]Event, error) {
+	req, err := c.newRequest("GET", p.link, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if secs, err := strconv.Atoi(resp.Header.Get("X-Poll-Interval")); err == nil && secs > 0 {
+		p.interval = time.Duration(secs) * time.Second
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, responseError(resp)
+	}
+
+	var events []Event
+	if err := c.decodeJSON(resp.Body, &events); err != nil {
+		return nil, err
+	}
+
+	// The feed is newest first. After the first poll, follow it back
+	// page by page until reaching an event returned before; if there is
+	// none, more events happened between polls than the feed keeps.
+	var missed bool
+	if len(p.seen) > 0 {
+		next := parseRel(resp.Header.Get("Link"), "next")
+		for !p.seenAny(events) {
+			if next == "" {
+				missed = true
+				break
+			}
+			var page []Event
+			next, err = c.loadPage(next, &page)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, page...)
+		}
+	}
+	p.etag = resp.Header.Get("ETag")
+
+	// Events drop off the end of the feed as new ones arrive, so only
+	// the IDs from this poll need to be remembered.
+	var fresh []Event
+	seen := make(map[string]bool, len(events))
+	for i := len(events) - 1; i >= 0; i-- {
+		seen[events[i].ID] = true
+		if !p.seen[events[i].ID] {
+			fresh = append(fresh, events[i])
+		}
+	}
+	p.seen = seen
+	if missed {
+		return fresh, ErrEventsMissed
+	}
+	return fresh, nil
+}
+
+// seenAny returns true if any of the events was returned by a previous
+// poll.
+func (p *EventPoller) seenAny(events []Event) bool {
+	for _, ev := range events {
+		if p.seen[ev.ID] {
+			return true
+		}
+	}
+	return false
+}
+
+// Run polls until the context is cancelled, calling fn for each new
+// event. Poll errors, including ErrEventsMissed, are passed to onError if
+// it is not nil, and polling continues with backoff. The context's error
+// is returned.
+func (p *EventPoller) Run(ctx context.Context, fn func(Event), onError func(error)) error {
+	c := p.client.WithContext(ctx)
+	return pollLoop(ctx, p.Interval, onError, func() error {
+		events, err := p.poll(c)
+		for _, ev := range events {
+			fn(ev)
+		}
+		if errors.Is(err, ErrEventsMissed) {
+			if onError != nil {
+				onError(err)
+			}
+			return nil
+		}
+		return err
+	})
+}
+
+// maxPollBackoff caps the wait between failing polls.
+const maxPollBackoff = 15 * time.Minute
+
+// pollLoop calls poll until the context is cancelled, waiting the current
+// interval between calls. Errors are passed to onError, if not nil, and
+// double the wait each time they repeat, up to maxPollBackoff.
+func pollLoop(ctx context.Context, interval func() time.Duration, onError func(error), poll func() error) error {
+	var backoff time.Duration
+	for {
+		err := poll()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		wait := interval()
+		if err != nil {
+			if onError != nil {
+				onError(err)
+			}
+			backoff *= 2
+			if backoff > maxPollBackoff {
+				backoff = maxPollBackoff
+			}
+			if backoff < wait {
+				backoff = wait
+			}
+			wait = backoff
+		} else {
+			backoff = 0
+		}
+
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package github
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// GitObject identifies the object a reference or tag points to.
+type GitObject struct {
+	Type string `json:"type"` // "commit", "tag", "tree" or "blob"
+	SHA  string `json:"sha"`
+	URL  string `json:"url"`
+}
+
+// Ref is a git reference, such as a branch or tag.
+type Ref struct {
+	Ref    string    `json:"ref"` // "refs/heads/main", "refs/tags/v1.0.0", ...
+	URL    string    `json:"url"`
+	Object GitObject `json:"object"`
+}
+
+// Tag is an annotated tag object.
+type Tag struct {
+	SHA     string       `json:"sha"`
+	URL     string       `json:"url"`
+	Tag     string       `json:"tag"`
+	Message string       `json:"message"`
+	Tagger  CommitAuthor `json:"tagger"`
+	Object  GitObject    `json:"object"`
+}
+
+// TagRequest describes a tag to create with CreateTag.
+type TagRequest struct {
+	Tag string // "v1.0.0"
+	SHA string // the commit to tag
+
+	// Message makes the tag annotated. Without it a lightweight tag is
+	// created.
+	Message string
+	// Tagger of an annotated tag defaults to the authenticated user.
+	Tagger *CommitIdentity
+}
+
+// Tree is a git tree.
+type Tree struct {
+	SHA       string      `json:"sha"`
+	URL       string      `json:"url"`
+	Tree      []TreeEntry `json:"tree"`
+	Truncated bool        `json:"truncated"` // the tree was too large to list in full
+}
+
+// TreeEntry is a file, directory, symlink or submodule in a tree.
+type TreeEntry struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"` // "100644", "100755", "040000", "120000" or "160000"
+	Type string `json:"type"` // "blob", "tree" or "commit"
+	SHA  string `json:"sha"`
+	Size int    `json:"size"` // blobs only
+	URL  string `json:"url"`
+}
+
+// Blob is a git blob, the content of a file.
+type Blob struct {
+	SHA     string
+	Size    int
+	URL     string
+	Content []byte
+}
+
+// fullRef returns the ref with its "refs/" prefix.
+func fullRef(ref string) string {
+	if strings.HasPrefix(ref, "refs/") {
+		return ref
+	}
+	return "refs/" + ref
+}
+
+// GetRef loads the reference, given as for example "heads/main" or
+// "tags/v1.0.0".
+func (c *Client) GetRef(repo, ref string) (Ref, error) {
+	link := c.apiURL("repos", repo, "git/ref", escapePath(strings.TrimPrefix(ref, "refs/")))
+	var res Ref
+	if err := c.requestInto(link, &res); err != nil {
+		return Ref{}, err
+	}
+	return res, nil
+}
+
+// GetRef is a wrapper around DefaultClient.GetRef.
+func GetRef(repo, ref string) (Ref, error) {
+	return DefaultClient.GetRef(repo, ref)
+}
+
+// CreateRef creates the reference, given as for example "heads/feature",
+// pointing at the object.
+func (c *Client) CreateRef(repo, ref, sha string) (Ref, error) {
+	link := c.apiURL("repos", repo, "git/refs")
+	req := map[string]string{"ref": fullRef(ref), "sha": sha}
+	var res Ref
+	if err := c.request("POST", link, req, &res); err != nil {
+		return Ref{}, err
+	}
+	return res, nil
+}
+
+// CreateRef is a wrapper around DefaultClient.CreateRef.
+func CreateRef(repo, ref, sha string) (Ref, error) {
+	return DefaultClient.CreateRef(repo, ref, sha)
+}
+
+// UpdateRef points the reference at another object. Unless force is set,
+// the update must be a fast forward.
+func (c *Client) UpdateRef(repo, ref, sha string, force bool) (Ref, error) {
+	link := c.apiURL("repos", repo, "git/refs", escapePath(strings.TrimPrefix(ref, "refs/")))
+	req := struct {
+		SHA   string `json:"sha"`
+		Force bool   `json:"force"`
+	}{sha, force}
+	var res Ref
+	if err := c.request("PATCH", link, req, &res); err != nil {
+		return Ref{}, err
+	}
+	return res, nil
+}
+
+// UpdateRef is a wrapper around DefaultClient.UpdateRef.
+func UpdateRef(repo, ref, sha string, force bool) (Ref, error) {
+	return DefaultClient.UpdateRef(repo, ref, sha, force)
+}
+
+// DeleteRef deletes the reference.
+func (c *Client) DeleteRef(repo, ref string) error {
+	link := c.apiURL("repos", repo, "git/refs", escapePath(strings.TrimPrefix(ref, "refs/")))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteRef is a wrapper around DefaultClient.DeleteRef.
+func DeleteRef(repo, ref string) error {
+	return DefaultClient.DeleteRef(repo, ref)
+}
+
+// GetTag loads the annotated tag object with the given SHA. The SHA of a
+// tag's object is found with GetRef on "tags/<name>".
+func (c *Client) GetTag(repo, sha string) (Tag, error) {
+	link := c.apiURL("repos", repo, "git/tags", sha)
+	var tag Tag
+	if err := c.requestInto(link, &tag); err != nil {
+		return Tag{}, err
+	}
+	return tag, nil
+}
+
+// GetTag is a wrapper around DefaultClient.GetTag.
+func GetTag(repo, sha string) (Tag, error) {
+	return DefaultClient.GetTag(repo, sha)
+}
+
+// CreateTag tags the commit. An annotated tag object is created when the
+// request has a message, followed by the tag reference.
+func (c *Client) CreateTag(repo string, tag TagRequest) (Ref, error) {
+	sha := tag.SHA
+	if tag.Message != "" {
+		link := c.apiURL("repos", repo, "git/tags")
+		req := struct {
+			Tag     string          `json:"tag"`
+			Message string          `json:"message"`
+			Object  string          `json:"object"`
+			Type    string          `json:"type"`
+			Tagger  *CommitIdentity `json:"tagger,omitempty"`
+		}{tag.Tag, tag.Message, tag.SHA, "commit", tag.Tagger}
+		var obj Tag
+		if err := c.request("POST", link, req, &obj); err != nil {
+			return Ref{}, err
+		}
+		sha = obj.SHA
+	}
+	return c.CreateRef(repo, "tags/"+tag.Tag, sha)
+}
+
+// CreateTag is a wrapper around DefaultClient.CreateTag.
+func CreateTag(repo string, tag TagRequest) (Ref, error) {
+	return DefaultClient.CreateTag(repo, tag)
+}
+
+// GetTree loads the tree with the given SHA, or of the given commit or
+// branch. With recursive set, the entries of subtrees are included.
+func (c *Client) GetTree(repo, sha string, recursive bool) (Tree, error) {
+	link := c.apiURL("repos", repo, "git/trees", sha)
+	if recursive {
+		link += "?recursive=1"
+	}
+	var tree Tree
+	if err := c.requestInto(link, &tree); err != nil {
+		return Tree{}, err
+	}
+	return tree, nil
+}
+
+// GetTree is a wrapper around DefaultClient.GetTree.
+func GetTree(repo, sha string, recursive bool) (Tree, error) {
+	return DefaultClient.GetTree(repo, sha, recursive)
+}
+
+// GetBlob loads the blob with the given SHA.
+func (c *Client) GetBlob(repo, sha string) (Blob, error) {
+	link := c.apiURL("repos", repo, "git/blobs", sha)
+	var res struct {
+		SHA      string `json:"sha"`
+		Size     int    `json:"size"`
+		URL      string `json:"url"`
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"` // "base64" or "utf-8"
+	}
+	if err := c.requestInto(link, &res); err != nil {
+		return Blob{}, err
+	}
+
+	blob := Blob{SHA: res.SHA, Size: res.Size, URL: res.URL, Content: []byte(res.Content)}
+	if res.Encoding == "base64" {
+		// The encoded content is split into lines.
+		data, err := base64.StdEncoding.DecodeString(strings.Replace(res.Content, "\n", "", -1))
+		if err != nil {
+			return Blob{}, err
+		}
+		blob.Content = data
+	}
+	return blob, nil
+}
+
+// GetBlob is a wrapper around DefaultClient.GetBlob.
+func GetBlob(repo, sha string) (Blob, error) {
+	return DefaultClient.GetBlob(repo, sha)
+}
+
+// CreateBlob stores the content as a blob, returning its SHA.
+func (c *Client) CreateBlob(repo string, content []byte) (string, error) {
+	link := c.apiURL("repos", repo, "git/blobs")
+	req := map[string]string{
+		"content":  base64.StdEncoding.EncodeToString(content),
+		"encoding": "base64",
+	}
+	var res struct {
+		SHA string `json:"sha"`
+	}
+	if err := c.request("POST", link, req, &res); err != nil {
+		return "", err
+	}
+	return res.SHA, nil
+}
+
+// CreateBlob is a wrapper around DefaultClient.CreateBlob.
+func CreateBlob(repo string, content []byte) (string, error) {
+	return DefaultClient.CreateBlob(repo, content)
+}
@@ -1,15 +1,13 @@
 package github
 
 import (
+	"bytes"
 	"encoding/json"
-	"fmt"
 	"html/template"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"os"
-	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -20,28 +18,33 @@ import (
 )
 
 type Issue struct {
-	ID          int
-	URL         string
-	HTMLURL     string `json:"html_url"`
-	Number      int
-	State       string
-	Title       string
-	Body        string
-	User        User
-	Labels      []Label
-	Assignee    User
-	Milestone   Milestone
+	ID          int       `json:"id"`
+	URL         string    `json:"url"`
+	HTMLURL     string    `json:"html_url"`
+	Number      int       `json:"number"`
+	State       string    `json:"state"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	User        User      `json:"user"`
+	Labels      []Label   `json:"labels"`
+	Assignee    User      `json:"assignee"` // zero if unassigned
+	Assignees   []User    `json:"assignees"`
+	Milestone   Milestone `json:"milestone"` // zero if not in a milestone
+	Reactions   Reactions `json:"reactions"`
 	PullRequest struct {
-		URL string
+		URL      string     `json:"url"`
+		MergedAt *time.Time `json:"merged_at"` // nil unless a merged pull request
 	} `json:"pull_request"`
-	Closed  *time.Time `json:"closed_at"` // nil for open issues
-	Created time.Time  `json:"created_at"`
-	Updated time.Time  `json:"updated_at"`
+	RepositoryURL string     `json:"repository_url"`
+	Closed        *time.Time `json:"closed_at"` // nil for open issues
+	Created       time.Time  `json:"created_at"`
+	Updated       time.Time  `json:"updated_at"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
 }
 
 func (i Issue) BodyHTML() template.HTML {
-	unsafe := blackfriday.MarkdownCommon([]byte(i.Body))
-	return template.HTML(bluemonday.UGCPolicy().SanitizeBytes(unsafe))
+	return renderMarkdown(i.Body)
 }
 
 func (i Issue) Type() string {
@@ -52,198 +55,443 @@ func (i Issue) Type() string {
 }
 
 type Milestone struct {
-	URL         string
-	HTMLURL     string `json:"html_url"`
-	ID          int
-	Number      int
-	State       string
-	Title       string
-	Description string
-	Creator     User
-	Due         *time.Time `json:"due_on"`
-	Closed      *time.Time `json:"closed_at"` // nil for open milestones
-	Created     time.Time  `json:"created_at"`
-	Updated     time.Time  `json:"updated_at"`
+	URL          string     `json:"url"`
+	HTMLURL      string     `json:"html_url"`
+	ID           int        `json:"id"`
+	Number       int        `json:"number"`
+	State        string     `json:"state"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	Creator      User       `json:"creator"`
+	OpenIssues   int        `json:"open_issues"`
+	ClosedIssues int        `json:"closed_issues"`
+	Due          *time.Time `json:"due_on"`
+	Closed       *time.Time `json:"closed_at"` // nil for open milestones
+	Created      time.Time  `json:"created_at"`
+	Updated      time.Time  `json:"updated_at"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+// MarshalJSON encodes the zero Milestone as null, as GitHub sends for
+// issues without a milestone.
+func (m Milestone) MarshalJSON() ([]byte, error) {
+	type milestone Milestone
+	zero := m
+	zero.Raw = nil
+	if reflect.DeepEqual(zero, Milestone{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(milestone(m))
+}
+
+func (m Milestone) DescriptionHTML() template.HTML {
+	return renderMarkdown(m.Description)
 }
 
 type User struct {
-	Login string
-	ID    int
-	Email string
+	Login string `json:"login"`
+	ID    int    `json:"id"`
+	Email string `json:"email,omitempty"`
+
+	AvatarURL string `json:"avatar_url,omitempty"`
+	HTMLURL   string `json:"html_url,omitempty"`
+	Type      string `json:"type,omitempty"` // "User", "Organization" or "Bot"
+
+	// Profile, only present when loading a single user.
+	Name        string     `json:"name,omitempty"`
+	Company     string     `json:"company,omitempty"`
+	Blog        string     `json:"blog,omitempty"`
+	Location    string     `json:"location,omitempty"`
+	Bio         string     `json:"bio,omitempty"`
+	PublicRepos int        `json:"public_repos,omitempty"`
+	Followers   int        `json:"followers,omitempty"`
+	Following   int        `json:"following,omitempty"`
+	Created     *time.Time `json:"created_at,omitempty"`
+}
+
+// MarshalJSON encodes the zero User as null, as GitHub sends for missing
+// users such as the assignee of an unassigned issue.
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	if u == (User{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(user(u))
 }
 
 type Label struct {
-	Name  string
-	Color string
+	ID          int64  `json:"id"`
+	URL         string `json:"url"`
+	Name        string `json:"name"`
+	Color       string `json:"color"` // hex, without the leading "#"
+	Description string `json:"description"`
+	Default     bool   `json:"default"`
 }
 
 type Release struct {
-	ID         int
-	TagName    string `json:"tag_name"`
-	Name       string
-	Body       string
-	Draft      bool
-	Prerelease bool
+	ID         int       `json:"id"`
+	TagName    string    `json:"tag_name"`
+	Name       string    `json:"name"`
+	Body       string    `json:"body"`
+	Draft      bool      `json:"draft"`
+	Prerelease bool      `json:"prerelease"`
 	Created    time.Time `json:"created_at"`
 	Published  time.Time `json:"published_at"`
-	Author     User
-	Assets     []Asset
+	Author     User      `json:"author"`
+	Assets     []Asset   `json:"assets"`
+
+	TargetCommitish string `json:"target_commitish"`
+	URL             string `json:"url"`
+	HTMLURL         string `json:"html_url"`
+	UploadURL       string `json:"upload_url"` // URI template for asset uploads
+	TarballURL      string `json:"tarball_url"`
+	ZipballURL      string `json:"zipball_url"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+func (r Release) BodyHTML() template.HTML {
+	return renderMarkdown(r.Body)
 }
 
 type Asset struct {
-	BrowserDownloadURL string `json:"browser_download_url"`
-	ID                 int
-	Name               string
-	Label              string
-	State              string
-	ContentType        string `json:"content_type"`
-	Size               int
+	URL                string    `json:"url"`
+	BrowserDownloadURL string    `json:"browser_download_url"`
+	ID                 int       `json:"id"`
+	Name               string    `json:"name"`
+	Label              string    `json:"label"`
+	State              string    `json:"state"`
+	ContentType        string    `json:"content_type"`
+	Size               int       `json:"size"`
+	Digest             string    `json:"digest,omitempty"` // "sha256:<hex>", empty for older assets
 	DownloadCount      int       `json:"download_count"`
 	Created            time.Time `json:"created_at"`
 	Updated            time.Time `json:"updated_at"`
-	Uploader           User
+	Uploader           User      `json:"uploader"`
 }
 
 type Team struct {
-	Name string
-	ID   int
-	Slug string
-	URL  string
+	Name string `json:"name"`
+	ID   int    `json:"id"`
+	Slug string `json:"slug"`
+	URL  string `json:"url"`
+
+	HTMLURL     string `json:"html_url"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`    // "closed" or "secret"
+	Permission  string `json:"permission"` // default repository permission
+	Parent      *Team  `json:"parent"`     // nil for top level teams
 }
 
 type Notification struct {
-	ID         json.Number
+	ID         json.Number `json:"id"`
 	Repository struct {
 		Name string `json:"full_name"`
-	}
+	} `json:"repository"`
 	Subject struct {
-		Title string
-		Type  string
-		URL   string
-	}
-	Reason string
-	Unread bool
+		Title            string `json:"title"`
+		Type             string `json:"type"`
+		URL              string `json:"url"`
+		LatestCommentURL string `json:"latest_comment_url"`
+	} `json:"subject"`
+	Reason   string     `json:"reason"` // compare with string(NotificationReasonMention), ...
+	Unread   bool       `json:"unread"`
+	Updated  time.Time  `json:"updated_at"`
+	LastRead *time.Time `json:"last_read_at"` // nil if never read
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
 }
 
-func LoadIssues(repo string, query url.Values) ([]Issue, error) {
-	link := "https://" + path.Join("api.github.com/repos", repo, "issues")
+func (c *Client) LoadIssues(repo string, query url.Values) ([]Issue, error) {
+	link := c.apiURL("repos", repo, "issues")
 	if query != nil {
 		link += "?" + query.Encode()
 	}
-	issues, err := loadSlice(link, Issue{})
+	issues, err := c.loadSlice(link, Issue{})
 	if err != nil {
 		return nil, err
 	}
 	return issues.([]Issue), nil
 }
 
-func LoadMilestones(repo string, query url.Values) ([]Milestone, error) {
-	link := "https://" + path.Join("api.github.com/repos", repo, "milestones")
+// LoadIssues is a wrapper around DefaultClient.LoadIssues.
+func LoadIssues(repo string, query url.Values) ([]Issue, error) {
+	return DefaultClient.LoadIssues(repo, query)
+}
+
+func (c *Client) LoadMilestones(repo string, query url.Values) ([]Milestone, error) {
+	link := c.apiURL("repos", repo, "milestones")
 	if query != nil {
 		link += "?" + query.Encode()
 	}
-	issues, err := loadSlice(link, Milestone{})
+	issues, err := c.loadSlice(link, Milestone{})
 	if err != nil {
 		return nil, err
 	}
 	return issues.([]Milestone), nil
 }
 
-func LoadReleases(repo string) ([]Release, error) {
-	link := "https://" + path.Join("api.github.com/repos", repo, "releases")
-	rels, err := loadSlice(link, Release{})
+// LoadMilestones is a wrapper around DefaultClient.LoadMilestones.
+func LoadMilestones(repo string, query url.Values) ([]Milestone, error) {
+	return DefaultClient.LoadMilestones(repo, query)
+}
+
+func (c *Client) LoadMilestone(repo string, number int) (Milestone, error) {
+	link := c.apiURL("repos", repo, "milestones", strconv.Itoa(number))
+	var milestone Milestone
+	if err := c.requestInto(link, &milestone); err != nil {
+		return Milestone{}, err
+	}
+	return milestone, nil
+}
+
+// LoadMilestone is a wrapper around DefaultClient.LoadMilestone.
+func LoadMilestone(repo string, number int) (Milestone, error) {
+	return DefaultClient.LoadMilestone(repo, number)
+}
+
+func (c *Client) LoadReleases(repo string) ([]Release, error) {
+	link := c.apiURL("repos", repo, "releases")
+	rels, err := c.loadSlice(link, Release{})
 	if err != nil {
 		return nil, err
 	}
 	return rels.([]Release), nil
 }
 
-func LoadTeams(org string) ([]Team, error) {
-	link := "https://" + path.Join("api.github.com/orgs", org, "teams")
-	rels, err := loadSlice(link, Team{})
+// LoadReleases is a wrapper around DefaultClient.LoadReleases.
+func LoadReleases(repo string) ([]Release, error) {
+	return DefaultClient.LoadReleases(repo)
+}
+
+func (c *Client) LoadRelease(repo string, id int) (Release, error) {
+	link := c.apiURL("repos", repo, "releases", strconv.Itoa(id))
+	var rel Release
+	if err := c.requestInto(link, &rel); err != nil {
+		return Release{}, err
+	}
+	return rel, nil
+}
+
+// LoadRelease is a wrapper around DefaultClient.LoadRelease.
+func LoadRelease(repo string, id int) (Release, error) {
+	return DefaultClient.LoadRelease(repo, id)
+}
+
+func (c *Client) LoadTeams(org string) ([]Team, error) {
+	link := c.apiURL("orgs", org, "teams")
+	rels, err := c.loadSlice(link, Team{})
 	if err != nil {
 		return nil, err
 	}
 	return rels.([]Team), nil
 }
 
-func LoadTeamMembers(teamID int) ([]User, error) {
-	link := "https://" + path.Join("api.github.com/teams", strconv.Itoa(teamID), "members")
-	rels, err := loadSlice(link, User{})
+// LoadTeams is a wrapper around DefaultClient.LoadTeams.
+func LoadTeams(org string) ([]Team, error) {
+	return DefaultClient.LoadTeams(org)
+}
+
+// LoadTeamMembers loads the members of the team.
+//
+// Deprecated: GitHub is retiring the routes addressing teams by ID; use
+// LoadTeamMembersBySlug.
+func (c *Client) LoadTeamMembers(teamID int) ([]User, error) {
+	link := c.apiURL("teams", strconv.Itoa(teamID), "members")
+	rels, err := c.loadSlice(link, User{})
 	if err != nil {
 		return nil, err
 	}
 	return rels.([]User), nil
 }
 
-func LoadNotifications() ([]Notification, error) {
-	link := "https://" + path.Join("api.github.com/notifications")
-	rels, err := loadSlice(link, Notification{})
+// LoadTeamMembers is a wrapper around DefaultClient.LoadTeamMembers.
+//
+// Deprecated: use LoadTeamMembersBySlug.
+func LoadTeamMembers(teamID int) ([]User, error) {
+	return DefaultClient.LoadTeamMembers(teamID)
+}
+
+func (c *Client) LoadNotifications() ([]Notification, error) {
+	link := c.apiURL("notifications")
+	rels, err := c.loadSlice(link, Notification{})
 	if err != nil {
 		return nil, err
 	}
 	return rels.([]Notification), nil
 }
 
-func GetUserEmail(username string) (string, error) {
-	link := "https://" + path.Join("api.github.com/users", username)
+// LoadNotifications is a wrapper around DefaultClient.LoadNotifications.
+func LoadNotifications() ([]Notification, error) {
+	return DefaultClient.LoadNotifications()
+}
+
+func (c *Client) LoadNotificationThread(id string) (Notification, error) {
+	link := c.apiURL("notifications/threads", id)
+	var notif Notification
+	if err := c.requestInto(link, &notif); err != nil {
+		return Notification{}, err
+	}
+	return notif, nil
+}
+
+// LoadNotificationThread is a wrapper around DefaultClient.LoadNotificationThread.
+func LoadNotificationThread(id string) (Notification, error) {
+	return DefaultClient.LoadNotificationThread(id)
+}
+
+// GetUserEmail returns the public email address of the user. If the user
+// has no public email and the client's CommitEmailFallback is set, the
+// most commonly used author email of the user's commits in their recent
+// public pushes is returned instead.
+func (c *Client) GetUserEmail(username string) (string, error) {
+	link := c.apiURL("users", username)
 	var user User
-	if err := requestInto(link, &user); err != nil {
+	if err := c.requestInto(link, &user); err != nil {
 		return "", err
 	}
+	if user.Email == "" && c.CommitEmailFallback {
+		return c.commitEmail(user)
+	}
 	return user.Email, nil
 }
 
-func requestInto(link string, v interface{}) error {
-	req, err := http.NewRequest("GET", link, nil)
+// GetUserEmail is a wrapper around DefaultClient.GetUserEmail.
+func GetUserEmail(username string) (string, error) {
+	return DefaultClient.GetUserEmail(username)
+}
+
+func (c *Client) requestInto(link string, v interface{}, opts ...requestOption) error {
+	return c.request("GET", link, nil, v, opts...)
+}
+
+// request performs an API request, sending body (unless nil) encoded as
+// JSON and decoding the response into v (unless nil).
+func (c *Client) request(method, link string, body, v interface{}, opts ...requestOption) error {
+	var r io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(bs)
+	}
+
+	req, err := c.newRequest(method, link, r)
 	if err != nil {
 		return err
 	}
 
-	setAuthentication(req)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.Do(req)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode > 299 {
-		lr := io.LimitReader(resp.Body, 1024)
-		bs, _ := ioutil.ReadAll(lr)
-		return fmt.Errorf("http.Get: %v (%s)", resp.Status, bs)
+		return responseError(resp)
+	}
+
+	if v == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return c.decodeJSON(resp.Body, v)
+}
+
+// download performs a GET request and streams the response body to w,
+// returning the number of bytes written.
+func (c *Client) download(link string, w io.Writer, opts ...requestOption) (int64, error) {
+	req, err := c.newRequest("GET", link, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return 0, responseError(resp)
+	}
+	return io.Copy(w, resp.Body)
+}
+
+// loadPage loads a single page of a paginated list into v, returning the
+// link to the next page, if any.
+func (c *Client) loadPage(link string, v interface{}) (string, error) {
+	req, err := c.newRequest("GET", link, nil)
+	if err != nil {
+		return "", err
 	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(v)
+	if resp.StatusCode > 299 {
+		return "", responseError(resp)
+	}
+	if err := c.decodeJSON(resp.Body, v); err != nil {
+		return "", err
+	}
+	return parseRel(resp.Header.Get("Link"), "next"), nil
 }
 
 // loadSlice loads url and decodes it into a []elemType, returning the []elemType and error.
-func loadSlice(url string, elemType interface{}) (interface{}, error) {
+func (c *Client) loadSlice(url string, elemType interface{}, opts ...requestOption) (interface{}, error) {
+	return c.loadSliceField(url, "", elemType, opts...)
+}
+
+// loadSliceField is like loadSlice, for endpoints that return the list in
+// the named field of an object instead of as a bare array.
+func (c *Client) loadSliceField(url, field string, elemType interface{}, opts ...requestOption) (interface{}, error) {
 	t := reflect.TypeOf(elemType)
 	result := reflect.New(reflect.SliceOf(t)).Elem() // result is []elemType
 
 	link := url
 	for link != "" {
-		req, err := http.NewRequest("GET", link, nil)
+		req, err := c.newRequest("GET", link, nil)
 		if err != nil {
 			return result.Interface(), err
 		}
 
-		setAuthentication(req)
+		for _, opt := range opts {
+			opt(req)
+		}
 
-		resp, err := http.DefaultClient.Do(req)
+		resp, err := c.Do(req)
 		if err != nil {
 			return result.Interface(), err
 		}
 		if resp.StatusCode > 299 {
-			lr := io.LimitReader(resp.Body, 1024)
-			bs, _ := ioutil.ReadAll(lr)
+			err := responseError(resp)
 			resp.Body.Close()
-			return result.Interface(), fmt.Errorf("http.Get: %v (%s)", resp.Status, bs)
+			return result.Interface(), err
 		}
 
 		tmp := reflect.New(reflect.SliceOf(t)) // tmp is *[]elemType
-		err = json.NewDecoder(resp.Body).Decode(tmp.Interface())
+		if field == "" {
+			err = c.decodeJSON(resp.Body, tmp.Interface())
+		} else {
+			var obj map[string]json.RawMessage
+			if err = json.NewDecoder(resp.Body).Decode(&obj); err == nil && obj[field] != nil {
+				err = c.unmarshalJSON(obj[field], tmp.Interface())
+			}
+		}
 		resp.Body.Close()
 		if err != nil {
 			return result.Interface(), err
@@ -256,6 +504,42 @@ func loadSlice(url string, elemType interface{}) (interface{}, error) {
 	return result.Interface(), nil
 }
 
+// A requestOption modifies an API request before it is sent.
+type requestOption func(*http.Request)
+
+// withAccept requests the given media type instead of the default.
+func withAccept(mediaType string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", mediaType)
+	}
+}
+
+// withAuthorization uses the given Authorization header value instead of
+// the default authentication.
+func withAuthorization(auth string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Authorization", auth)
+	}
+}
+
+// renderMarkdown renders GitHub flavored markdown to sanitized HTML.
+func renderMarkdown(body string) template.HTML {
+	unsafe := blackfriday.MarkdownCommon([]byte(body))
+	return template.HTML(bluemonday.UGCPolicy().SanitizeBytes(unsafe))
+}
+
+// responseError returns an error describing the unsuccessful response: an
+// *SSOError for SSO enforcement refusals, otherwise an *APIError.
+func responseError(resp *http.Response) error {
+	lr := io.LimitReader(resp.Body, 1024)
+	bs, _ := ioutil.ReadAll(lr)
+	apiErr := newAPIError(resp, bs)
+	if err := ssoError(resp, apiErr); err != nil {
+		return err
+	}
+	return apiErr
+}
+
 func parseRel(link, rel string) string {
 	exp := regexp.MustCompile(`<([^>]+)>;\s+rel="` + rel + `"`)
 	match := exp.FindStringSubmatch(link)
@@ -264,11 +548,3 @@ func parseRel(link, rel string) string {
 	}
 	return ""
 }
-
-func setAuthentication(req *http.Request) {
-	username := os.Getenv("GITHUB_USERNAME")
-	token := os.Getenv("GITHUB_TOKEN")
-	if username != "" && token != "" {
-		req.SetBasicAuth(username, token)
-	}
-}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	cases := []struct {
+		file string
+		new  func() interface{}
+	}{
+		{"issue.json", func() interface{} { return new(Issue) }},
+		{"pull_request.json", func() interface{} { return new(PullRequest) }},
+		{"milestone.json", func() interface{} { return new(Milestone) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.file, func(t *testing.T) {
+			fixture, err := ioutil.ReadFile(filepath.Join("testdata", tc.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			first := tc.new()
+			if err := json.Unmarshal(fixture, first); err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := json.Marshal(first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			second := tc.new()
+			if err := json.Unmarshal(encoded, second); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("round trip changed the value:\n%+v\n%+v", first, second)
+			}
+
+			// Nulls in the fixture must stay null, rather than turn into
+			// zero valued objects.
+			var in, out map[string]interface{}
+			if err := json.Unmarshal(fixture, &in); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(encoded, &out); err != nil {
+				t.Fatal(err)
+			}
+			for key, val := range in {
+				if val != nil {
+					continue
+				}
+				if got, ok := out[key]; ok && got != nil {
+					t.Errorf("%s: null encoded as %v", key, got)
+				}
+			}
+		})
+	}
+}
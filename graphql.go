@@ -0,0 +1,134 @@
+package github
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage
+	Errors GraphQLErrors
+}
+
+// GraphQLError is an error reported in a GraphQL response.
+type GraphQLError struct {
+	Message string
+	Type    string        // "NOT_FOUND", "FORBIDDEN", "RATE_LIMITED", ...
+	Path    []interface{} // field names and list indexes leading to the error
+}
+
+// GraphQLErrors is the list of errors in a GraphQL response. It matches
+// ErrNotFound, ErrForbidden and ErrRateLimited with errors.Is when all
+// errors are of the corresponding type.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Message
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+func (e GraphQLErrors) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.onlyType("NOT_FOUND")
+	case ErrForbidden:
+		return e.onlyType("FORBIDDEN")
+	case ErrRateLimited:
+		return e.onlyType("RATE_LIMITED")
+	}
+	return false
+}
+
+// onlyType returns true if all errors are of the given type.
+func (e GraphQLErrors) onlyType(typ string) bool {
+	for _, err := range e {
+		if err.Type != typ {
+			return false
+		}
+	}
+	return true
+}
+
+// PageInfo is the pagination state of a GraphQL connection.
+type PageInfo struct {
+	HasNextPage bool
+	EndCursor   string
+}
+
+// Query runs the GraphQL query with the given variables and decodes the
+// data part of the response into v. If the response contains errors, any
+// partial data is decoded and GraphQLErrors is returned.
+func (c *Client) Query(query string, vars map[string]interface{}, v interface{}) error {
+	var res graphQLResponse
+	if err := c.request("POST", c.graphQLURL(), graphQLRequest{Query: query, Variables: vars}, &res); err != nil {
+		return err
+	}
+	if len(res.Data) > 0 && string(res.Data) != "null" {
+		if err := json.Unmarshal(res.Data, v); err != nil {
+			return err
+		}
+	}
+	if len(res.Errors) > 0 {
+		return res.Errors
+	}
+	return nil
+}
+
+// Query is a wrapper around DefaultClient.Query.
+func Query(query string, vars map[string]interface{}, v interface{}) error {
+	return DefaultClient.Query(query, vars, v)
+}
+
+// Mutate runs the GraphQL mutation with the given variables and decodes
+// the data part of the response into v, which may be nil.
+func (c *Client) Mutate(mutation string, vars map[string]interface{}, v interface{}) error {
+	if v == nil {
+		v = &struct{}{}
+	}
+	return c.Query(mutation, vars, v)
+}
+
+// Mutate is a wrapper around DefaultClient.Mutate.
+func Mutate(mutation string, vars map[string]interface{}, v interface{}) error {
+	return DefaultClient.Mutate(mutation, vars, v)
+}
+
+// QueryPages runs a query over a paginated connection, once per page. The
+// query must take the cursor of the page to load as the $cursor variable,
+// which is null for the first page. After each page has been decoded into
+// v, fn is called to consume it and return the connection's page info.
+// The same v is reused for every page.
+func (c *Client) QueryPages(query string, vars map[string]interface{}, v interface{}, fn func() (PageInfo, error)) error {
+	pageVars := make(map[string]interface{}, len(vars)+1)
+	for k, val := range vars {
+		pageVars[k] = val
+	}
+	pageVars["cursor"] = nil
+
+	for {
+		if err := c.Query(query, pageVars, v); err != nil {
+			return err
+		}
+		page, err := fn()
+		if err != nil {
+			return err
+		}
+		if !page.HasNextPage {
+			return nil
+		}
+		pageVars["cursor"] = page.EndCursor
+	}
+}
+
+// QueryPages is a wrapper around DefaultClient.QueryPages.
+func QueryPages(query string, vars map[string]interface{}, v interface{}, fn func() (PageInfo, error)) error {
+	return DefaultClient.QueryPages(query, vars, v, fn)
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"sort"
+)
+
+// IssueGroup is a named group of issues, as returned by the GroupBy
+// functions. Groups are returned as a slice, ordered by key, so that they
+// can be ranged over directly in templates.
+type IssueGroup struct {
+	Key    string
+	Issues []Issue
+}
+
+// GroupByMilestone groups issues by milestone title. Issues without a
+// milestone are in a last group with an empty key.
+func GroupByMilestone(issues []Issue) []IssueGroup {
+	return groupBy(issues, func(i Issue) []string {
+		return []string{i.Milestone.Title}
+	})
+}
+
+// GroupByLabel groups issues by label name. An issue with several labels
+// is in several groups; issues without labels are in a last group with an
+// empty key.
+func GroupByLabel(issues []Issue) []IssueGroup {
+	return groupBy(issues, func(i Issue) []string {
+		if len(i.Labels) == 0 {
+			return []string{""}
+		}
+		keys := make([]string, len(i.Labels))
+		for j, l := range i.Labels {
+			keys[j] = l.Name
+		}
+		return keys
+	})
+}
+
+// GroupByAssignee groups issues by assignee login. An issue with several
+// assignees is in several groups; unassigned issues are in a last group
+// with an empty key.
+func GroupByAssignee(issues []Issue) []IssueGroup {
+	return groupBy(issues, func(i Issue) []string {
+		if len(i.Assignees) == 0 {
+			return []string{i.Assignee.Login}
+		}
+		keys := make([]string, len(i.Assignees))
+		for j, u := range i.Assignees {
+			keys[j] = u.Login
+		}
+		return keys
+	})
+}
+
+// GroupByMonthClosed groups issues by the month they were closed, with keys
+// on the form "2006-01". Open issues are in a last group with an empty key.
+func GroupByMonthClosed(issues []Issue) []IssueGroup {
+	return groupBy(issues, func(i Issue) []string {
+		if i.Closed == nil {
+			return []string{""}
+		}
+		return []string{i.Closed.Format("2006-01")}
+	})
+}
+
+func groupBy(issues []Issue, keys func(Issue) []string) []IssueGroup {
+	var groups []IssueGroup
+	idx := make(map[string]int)
+	for _, i := range issues {
+		for _, key := range keys(i) {
+			n, ok := idx[key]
+			if !ok {
+				n = len(groups)
+				idx[key] = n
+				groups = append(groups, IssueGroup{Key: key})
+			}
+			groups[n].Issues = append(groups[n].Issues, i)
+		}
+	}
+	sort.SliceStable(groups, func(a, b int) bool {
+		if groups[a].Key == "" || groups[b].Key == "" {
+			return groups[b].Key == "" && groups[a].Key != ""
+		}
+		return groups[a].Key < groups[b].Key
+	})
+	return groups
+}
+
+// SortByAge sorts issues oldest first. The sort is stable.
+func SortByAge(issues []Issue) {
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].Created.Before(issues[b].Created)
+	})
+}
+
+// SortByUpdated sorts issues most recently updated first. The sort is
+// stable.
+func SortByUpdated(issues []Issue) {
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].Updated.After(issues[b].Updated)
+	})
+}
+
+// SortByReactions sorts issues by total number of reactions, most first.
+// The sort is stable.
+func SortByReactions(issues []Issue) {
+	sort.SliceStable(issues, func(a, b int) bool {
+		return issues[a].Reactions.TotalCount > issues[b].Reactions.TotalCount
+	})
+}
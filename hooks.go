@@ -0,0 +1,81 @@
+package github
+
+import (
+	"strconv"
+	"time"
+)
+
+// HookDelivery is a recorded delivery attempt of a webhook event.
+type HookDelivery struct {
+	ID             int64     `json:"id"`
+	GUID           string    `json:"guid"`
+	Delivered      time.Time `json:"delivered_at"`
+	Redelivery     bool      `json:"redelivery"`
+	Duration       float64   `json:"duration"` // seconds
+	Status         string    `json:"status"`
+	StatusCode     int       `json:"status_code"`
+	Event          string    `json:"event"`
+	Action         string    `json:"action"`
+	InstallationID int64     `json:"installation_id"`
+	RepositoryID   int64     `json:"repository_id"`
+}
+
+// Failed returns true if the delivery did not receive a successful
+// response.
+func (d HookDelivery) Failed() bool {
+	return d.StatusCode < 200 || d.StatusCode > 299
+}
+
+// LoadHookDeliveries loads the recent deliveries of the app's webhook.
+func (a *App) LoadHookDeliveries() ([]HookDelivery, error) {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return nil, err
+	}
+	dels, err := c.loadSlice(c.apiURL("app/hook/deliveries")+"?per_page=100", HookDelivery{}, withAuthorization("Bearer "+jwt))
+	if err != nil {
+		return nil, err
+	}
+	return dels.([]HookDelivery), nil
+}
+
+// RedeliverHookDelivery requests a new delivery attempt of the given app
+// webhook delivery.
+func (a *App) RedeliverHookDelivery(deliveryID int64) error {
+	c := a.client()
+	jwt, err := a.JWT()
+	if err != nil {
+		return err
+	}
+	link := c.apiURL("app/hook/deliveries", strconv.FormatInt(deliveryID, 10), "attempts")
+	return c.request("POST", link, nil, nil, withAuthorization("Bearer "+jwt))
+}
+
+// LoadRepoHookDeliveries loads the recent deliveries of the repository
+// webhook.
+func (c *Client) LoadRepoHookDeliveries(repo string, hookID int64) ([]HookDelivery, error) {
+	link := c.apiURL("repos", repo, "hooks", strconv.FormatInt(hookID, 10), "deliveries") + "?per_page=100"
+	dels, err := c.loadSlice(link, HookDelivery{})
+	if err != nil {
+		return nil, err
+	}
+	return dels.([]HookDelivery), nil
+}
+
+// LoadRepoHookDeliveries is a wrapper around DefaultClient.LoadRepoHookDeliveries.
+func LoadRepoHookDeliveries(repo string, hookID int64) ([]HookDelivery, error) {
+	return DefaultClient.LoadRepoHookDeliveries(repo, hookID)
+}
+
+// RedeliverRepoHookDelivery requests a new delivery attempt of the given
+// repository webhook delivery.
+func (c *Client) RedeliverRepoHookDelivery(repo string, hookID, deliveryID int64) error {
+	link := c.apiURL("repos", repo, "hooks", strconv.FormatInt(hookID, 10), "deliveries", strconv.FormatInt(deliveryID, 10), "attempts")
+	return c.request("POST", link, nil, nil)
+}
+
+// RedeliverRepoHookDelivery is a wrapper around DefaultClient.RedeliverRepoHookDelivery.
+func RedeliverRepoHookDelivery(repo string, hookID, deliveryID int64) error {
+	return DefaultClient.RedeliverRepoHookDelivery(repo, hookID, deliveryID)
+}
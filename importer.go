@@ -0,0 +1,284 @@
+package github
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportIssue is an issue to be created by an Importer.
+type ImportIssue struct {
+	Title     string          `json:"title"`
+	Body      string          `json:"body"`
+	Labels    []string        `json:"labels"`
+	Assignees []string        `json:"assignees"`
+	Milestone int             `json:"milestone"` // milestone number
+	Closed    bool            `json:"closed"`
+	Created   time.Time       `json:"created_at"` // only with PreserveTimestamps
+	ClosedAt  *time.Time      `json:"closed_at"`  // only with PreserveTimestamps
+	Comments  []ImportComment `json:"comments"`   // only with PreserveTimestamps
+}
+
+type ImportComment struct {
+	Body    string     `json:"body"`
+	Created *time.Time `json:"created_at,omitempty"` // import time if nil
+}
+
+// ImportResult is the outcome of importing one issue.
+type ImportResult struct {
+	Issue ImportIssue
+	// Created is the created issue when importing through the issues API.
+	Created *Issue
+	// StatusURL is where the status of the asynchronous import can be
+	// polled, when importing with PreserveTimestamps.
+	StatusURL string
+	Err       error
+}
+
+// ReadIssuesJSONL reads issues from JSON Lines, one ImportIssue object per
+// line. Empty lines are skipped.
+func ReadIssuesJSONL(r io.Reader) ([]ImportIssue, error) {
+	var issues []ImportIssue
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64<<10), 16<<20)
+	line := 0
+	for sc.Scan() {
+		line++
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		var issue ImportIssue
+		if err := json.Unmarshal(sc.Bytes(), &issue); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		issues = append(issues, issue)
+	}
+	return issues, sc.Err()
+}
+
+// ReadIssuesCSV reads issues from CSV with a header row. The recognized
+// columns are title, body, labels and assignees (separated by ";"),
+// milestone (number), state ("open" or "closed"), created_at and closed_at
+// (RFC 3339). Only title is required; unknown columns are ignored.
+func ReadIssuesCSV(r io.Reader) ([]ImportIssue, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	cols := make(map[string]int)
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := cols["title"]; !ok {
+		return nil, errors.New("CSV has no title column")
+	}
+
+	var issues []ImportIssue
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return issues, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		get := func(col string) string {
+			if i, ok := cols[col]; ok && i < len(rec) {
+				return strings.TrimSpace(rec[i])
+			}
+			return ""
+		}
+
+		issue := ImportIssue{
+			Title:     get("title"),
+			Body:      get("body"),
+			Labels:    splitList(get("labels")),
+			Assignees: splitList(get("assignees")),
+			Closed:    get("state") == "closed",
+		}
+		if v := get("milestone"); v != "" {
+			if issue.Milestone, err = strconv.Atoi(v); err != nil {
+				return nil, fmt.Errorf("line %d: milestone: %w", line, err)
+			}
+		}
+		if v := get("created_at"); v != "" {
+			if issue.Created, err = time.Parse(time.RFC3339, v); err != nil {
+				return nil, fmt.Errorf("line %d: created_at: %w", line, err)
+			}
+		}
+		if v := get("closed_at"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: closed_at: %w", line, err)
+			}
+			issue.ClosedAt = &t
+			issue.Closed = true
+		}
+		issues = append(issues, issue)
+	}
+}
+
+func splitList(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Importer creates issues in a repository.
+type Importer struct {
+	Repo string
+
+	// DryRun validates the issues and reports what would be done,
+	// without making any requests.
+	DryRun bool
+
+	// PreserveTimestamps uses GitHub's issue import endpoint, which keeps
+	// the creation and closing times and comments of the imported issues.
+	// Issues are then created asynchronously, and closed issues are
+	// imported closed.
+	PreserveTimestamps bool
+
+	// Interval is the minimum time between issue creations. GitHub
+	// recommends at least a second between content creating requests to
+	// avoid secondary rate limits. One second is used if zero.
+	Interval time.Duration
+
+	// Client makes the requests; DefaultClient is used if nil.
+	Client *Client
+}
+
+// Import creates the issues, one at a time, returning a result per issue.
+// It pauses until the rate limit resets when it runs out of requests. The
+// returned error is non-nil if any issue failed. If the client's context
+// is cancelled, the results so far are returned with the context's error.
+func (im *Importer) Import(issues []ImportIssue) ([]ImportResult, error) {
+	c := im.client()
+	interval := im.Interval
+	if interval == 0 {
+		interval = time.Second
+	}
+
+	results := make([]ImportResult, len(issues))
+	var errs MultiError
+	var last time.Time
+	for i, issue := range issues {
+		results[i].Issue = issue
+		if issue.Title == "" {
+			results[i].Err = fmt.Errorf("issue %d: missing title", i+1)
+			errs = append(errs, results[i].Err)
+			continue
+		}
+		if im.DryRun {
+			continue
+		}
+
+		if err := c.sleep(time.Until(last.Add(interval))); err != nil {
+			return results[:i], err
+		}
+		if rate, ok := c.Rate("core"); ok && rate.Remaining == 0 {
+			if err := c.sleep(time.Until(rate.Reset)); err != nil {
+				return results[:i], err
+			}
+		}
+		last = time.Now()
+
+		if im.PreserveTimestamps {
+			results[i].StatusURL, results[i].Err = im.importIssue(issue)
+		} else {
+			results[i].Created, results[i].Err = im.createIssue(issue)
+		}
+		if results[i].Err != nil {
+			results[i].Err = fmt.Errorf("issue %d (%s): %w", i+1, issue.Title, results[i].Err)
+			errs = append(errs, results[i].Err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, errs
+	}
+	return results, nil
+}
+
+func (im *Importer) createIssue(issue ImportIssue) (*Issue, error) {
+	c := im.client()
+	created, err := c.CreateIssue(im.Repo, IssueRequest{
+		Title:     issue.Title,
+		Body:      issue.Body,
+		Labels:    issue.Labels,
+		Assignees: issue.Assignees,
+		Milestone: issue.Milestone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if issue.Closed {
+		closed, err := c.CloseIssue(im.Repo, created.Number)
+		if err != nil {
+			return &created, err
+		}
+		created = closed
+	}
+	return &created, nil
+}
+
+func (im *Importer) client() *Client {
+	if im.Client != nil {
+		return im.Client
+	}
+	return DefaultClient
+}
+
+type legacyImport struct {
+	Issue struct {
+		Title     string     `json:"title"`
+		Body      string     `json:"body"`
+		Created   *time.Time `json:"created_at,omitempty"`
+		ClosedAt  *time.Time `json:"closed_at,omitempty"`
+		Assignee  string     `json:"assignee,omitempty"`
+		Milestone int        `json:"milestone,omitempty"`
+		Closed    bool       `json:"closed"`
+		Labels    []string   `json:"labels,omitempty"`
+	} `json:"issue"`
+	Comments []ImportComment `json:"comments,omitempty"`
+}
+
+// importIssue submits the issue to the import endpoint and returns the
+// status URL of the import.
+func (im *Importer) importIssue(issue ImportIssue) (string, error) {
+	c := im.client()
+	var req legacyImport
+	req.Issue.Title = issue.Title
+	req.Issue.Body = issue.Body
+	if !issue.Created.IsZero() {
+		req.Issue.Created = &issue.Created
+	}
+	req.Issue.ClosedAt = issue.ClosedAt
+	if len(issue.Assignees) > 0 {
+		// The import endpoint takes a single assignee.
+		req.Issue.Assignee = issue.Assignees[0]
+	}
+	req.Issue.Milestone = issue.Milestone
+	req.Issue.Closed = issue.Closed
+	req.Issue.Labels = issue.Labels
+	req.Comments = issue.Comments
+
+	link := c.apiURL("repos", im.Repo, "import/issues")
+	var res struct {
+		URL string
+	}
+	if err := c.request("POST", link, req, &res, withAccept("application/vnd.github.golden-comet-preview+json")); err != nil {
+		return "", err
+	}
+	return res.URL, nil
+}
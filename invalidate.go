@@ -0,0 +1,122 @@
+package github
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// invalidatedPaths lists, per webhook event, the repository API paths
+// whose cached responses are made stale by the event.
+var invalidatedPaths = map[string][]string{
+	"issues":              {"issues", "milestones"},
+	"issue_comment":       {"issues"},
+	"label":               {"labels", "issues"},
+	"milestone":           {"milestones", "issues"},
+	"pull_request":        {"pulls", "issues"},
+	"pull_request_review": {"pulls"},
+	"release":             {"releases"},
+	"push":                {"commits", "compare", "branches", "git", "contents", "readme", "tags"},
+	"create":              {"branches", "tags", "git"},
+	"delete":              {"branches", "tags", "git"},
+}
+
+// Invalidate removes all cached responses for URLs starting with prefix,
+// such as "https://api.github.com/repos/calmh/github/issues".
+func (t *Transport) Invalidate(prefix string) {
+	t.cache().DeletePrefix(prefix)
+}
+
+// InvalidateEvent removes the cached responses for the repository that are
+// made stale by a webhook event of the given type ("issues", "release",
+// "push", ...). The repository is either "owner/name" on github.com or
+// the API URL of the repository, as for GitHub Enterprise Server.
+func (t *Transport) InvalidateEvent(repo, event string) {
+	if !strings.Contains(repo, "://") {
+		repo = DefaultBaseURL + "repos/" + repo
+	}
+	repo = strings.TrimSuffix(repo, "/")
+	for _, p := range invalidatedPaths[event] {
+		t.Invalidate(repo + "/" + p)
+	}
+}
+
+// maxWebhookPayload is the largest webhook payload GitHub sends.
+const maxWebhookPayload = 25 << 20
+
+// InvalidationHandler returns an http.Handler for GitHub webhook
+// deliveries that invalidates the cached responses in t affected by each
+// event, before passing the request on to next. If next is nil the
+// delivery is acknowledged with 204 No Content.
+//
+// Deliveries must be signed with the webhook secret in the
+// X-Hub-Signature-256 header and are otherwise refused with 401
+// Unauthorized. Signatures are not verified if the secret is empty, which
+// should only be the case behind some other authentication. Both JSON and
+// form encoded (payload=...) deliveries are understood.
+func InvalidationHandler(t *Transport, secret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxWebhookPayload))
+		req.Body.Close()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if len(secret) > 0 && !validSignature(secret, body, req.Header.Get("X-Hub-Signature-256")) {
+			http.Error(w, "invalid webhook signature", http.StatusUnauthorized)
+			return
+		}
+
+		payload := body
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			payload = []byte(form.Get("payload"))
+		}
+
+		var event struct {
+			Repository struct {
+				FullName string `json:"full_name"`
+			} `json:"repository"`
+		}
+		if err := json.Unmarshal(payload, &event); err == nil && event.Repository.FullName != "" {
+			repo := event.Repository.FullName
+			if host := req.Header.Get("X-GitHub-Enterprise-Host"); host != "" {
+				repo = "https://" + host + "/api/v3/repos/" + repo
+			}
+			t.InvalidateEvent(repo, req.Header.Get("X-GitHub-Event"))
+		}
+
+		if next == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, req)
+	})
+}
+
+// validSignature returns true if the X-Hub-Signature-256 header value is
+// the HMAC-SHA256 of the body with the secret.
+func validSignature(secret, body []byte, signature string) bool {
+	const prefix = "sha256="
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,202 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// multiConcurrency is the maximum number of repositories loaded in
+// parallel by LoadIssuesMulti.
+const multiConcurrency = 4
+
+// Repo returns the "owner/name" of the repository the issue belongs to.
+func (i Issue) Repo() string {
+	u, err := url.Parse(i.RepositoryURL)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return path.Join(parts[len(parts)-2:]...)
+}
+
+// LoadOrgIssues loads the issues assigned to the authenticated user in
+// repositories owned by the organization.
+func (c *Client) LoadOrgIssues(org string, query url.Values) ([]Issue, error) {
+	link := c.apiURL("orgs", org, "issues")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	issues, err := c.loadSlice(link, Issue{})
+	if err != nil {
+		return nil, err
+	}
+	return issues.([]Issue), nil
+}
+
+// LoadOrgIssues is a wrapper around DefaultClient.LoadOrgIssues.
+func LoadOrgIssues(org string, query url.Values) ([]Issue, error) {
+	return DefaultClient.LoadOrgIssues(org, query)
+}
+
+// LoadMyIssues loads issues for the authenticated user across all
+// repositories it has access to. The "filter" query parameter selects
+// which issues, see IssueFilter; the default is IssueFilterAssigned.
+func (c *Client) LoadMyIssues(query url.Values) ([]Issue, error) {
+	link := c.apiURL("issues")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	issues, err := c.loadSlice(link, Issue{})
+	if err != nil {
+		return nil, err
+	}
+	return issues.([]Issue), nil
+}
+
+// LoadMyIssues is a wrapper around DefaultClient.LoadMyIssues.
+func LoadMyIssues(query url.Values) ([]Issue, error) {
+	return DefaultClient.LoadMyIssues(query)
+}
+
+// IssueRequest is the set of issue fields sent when creating an issue.
+type IssueRequest struct {
+	Title     string   `json:"title,omitempty"`
+	Body      string   `json:"body,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone int      `json:"milestone,omitempty"` // milestone number
+}
+
+// CreateIssue creates an issue in the repository.
+func (c *Client) CreateIssue(repo string, issue IssueRequest) (Issue, error) {
+	link := c.apiURL("repos", repo, "issues")
+	var created Issue
+	if err := c.request("POST", link, issue, &created); err != nil {
+		return Issue{}, err
+	}
+	return created, nil
+}
+
+// CreateIssue is a wrapper around DefaultClient.CreateIssue.
+func CreateIssue(repo string, issue IssueRequest) (Issue, error) {
+	return DefaultClient.CreateIssue(repo, issue)
+}
+
+// IssuePatch is a change to an issue. Nil fields are left unchanged.
+type IssuePatch struct {
+	Title       *string   `json:"title,omitempty"`
+	Body        *string   `json:"body,omitempty"`
+	State       *string   `json:"state,omitempty"`        // "open" or "closed"
+	StateReason *string   `json:"state_reason,omitempty"` // "completed", "not_planned" or "reopened"
+	Labels      *[]string `json:"labels,omitempty"`       // replaces all labels
+	Assignees   *[]string `json:"assignees,omitempty"`    // replaces all assignees
+	Milestone   *int      `json:"milestone,omitempty"`    // milestone number; zero removes the milestone
+}
+
+func (p IssuePatch) MarshalJSON() ([]byte, error) {
+	type patch IssuePatch
+	v := struct {
+		patch
+		Milestone interface{} `json:"milestone,omitempty"`
+	}{patch: patch(p)}
+	if p.Milestone != nil {
+		if *p.Milestone == 0 {
+			v.Milestone = json.RawMessage("null")
+		} else {
+			v.Milestone = *p.Milestone
+		}
+	}
+	return json.Marshal(v)
+}
+
+// EditIssue changes the issue fields set in the patch, returning the
+// updated issue.
+func (c *Client) EditIssue(repo string, number int, patch IssuePatch) (Issue, error) {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number))
+	var res Issue
+	if err := c.request("PATCH", link, patch, &res); err != nil {
+		return Issue{}, err
+	}
+	return res, nil
+}
+
+// EditIssue is a wrapper around DefaultClient.EditIssue.
+func EditIssue(repo string, number int, patch IssuePatch) (Issue, error) {
+	return DefaultClient.EditIssue(repo, number, patch)
+}
+
+// CloseIssue closes the issue as completed.
+func (c *Client) CloseIssue(repo string, number int) (Issue, error) {
+	return c.EditIssue(repo, number, IssuePatch{State: String("closed")})
+}
+
+// CloseIssue is a wrapper around DefaultClient.CloseIssue.
+func CloseIssue(repo string, number int) (Issue, error) {
+	return DefaultClient.CloseIssue(repo, number)
+}
+
+// ReopenIssue reopens a closed issue.
+func (c *Client) ReopenIssue(repo string, number int) (Issue, error) {
+	return c.EditIssue(repo, number, IssuePatch{State: String("open")})
+}
+
+// ReopenIssue is a wrapper around DefaultClient.ReopenIssue.
+func ReopenIssue(repo string, number int) (Issue, error) {
+	return DefaultClient.ReopenIssue(repo, number)
+}
+
+// LoadIssuesMulti loads issues matching query from all the given
+// repositories, a few at a time, and returns them merged and sorted most
+// recently updated first. Use Issue.Repo to tell where each issue came
+// from. Issues from the repositories that could be loaded are returned
+// even when others fail; the failures are returned as a MultiError.
+func (c *Client) LoadIssuesMulti(repos []string, query url.Values) ([]Issue, error) {
+	var wg sync.WaitGroup
+	var mut sync.Mutex
+	var all []Issue
+	var errs MultiError
+	sem := make(chan struct{}, multiConcurrency)
+	for _, repo := range repos {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(repo string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			issues, err := c.LoadIssues(repo, query)
+			mut.Lock()
+			defer mut.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", repo, err))
+				return
+			}
+			for i := range issues {
+				if issues[i].RepositoryURL == "" {
+					issues[i].RepositoryURL = c.apiURL("repos", repo)
+				}
+			}
+			all = append(all, issues...)
+		}(repo)
+	}
+	wg.Wait()
+
+	SortByUpdated(all)
+	if len(errs) > 0 {
+		return all, errs
+	}
+	return all, nil
+}
+
+// LoadIssuesMulti is a wrapper around DefaultClient.LoadIssuesMulti.
+func LoadIssuesMulti(repos []string, query url.Values) ([]Issue, error) {
+	return DefaultClient.LoadIssuesMulti(repos, query)
+}
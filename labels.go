@@ -0,0 +1,170 @@
+package github
+
+import (
+	"fmt"
+	"html/template"
+	"math"
+	"strconv"
+)
+
+// CSSColor returns the label color as a CSS hex color, "#ededed" if the
+// label color is missing or invalid.
+func (l Label) CSSColor() string {
+	if _, _, _, ok := l.rgb(); !ok {
+		return "#ededed"
+	}
+	return "#" + l.Color
+}
+
+// TextColor returns a CSS color, black or white, for readable text on top
+// of the label color.
+func (l Label) TextColor() string {
+	r, g, b, ok := l.rgb()
+	if !ok {
+		return "#000000"
+	}
+	// Pick whichever of black and white gives the higher WCAG contrast
+	// ratio against the background. The crossover point is a relative
+	// luminance of about 0.179.
+	lum := 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+	if lum > 0.179 {
+		return "#000000"
+	}
+	return "#ffffff"
+}
+
+// BadgeHTML returns a GitHub style label badge.
+func (l Label) BadgeHTML() template.HTML {
+	return template.HTML(fmt.Sprintf(`<span class="label" style="background-color: %s; color: %s; border-radius: 2em; padding: 0 0.6em; font-size: 85%%; font-weight: 500">%s</span>`,
+		l.CSSColor(), l.TextColor(), template.HTMLEscapeString(l.Name)))
+}
+
+func (l Label) rgb() (r, g, b uint8, ok bool) {
+	if len(l.Color) != 6 {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(l.Color, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// linear converts an sRGB color component to linear light.
+func linear(c uint8) float64 {
+	v := float64(c) / 255
+	if v <= 0.03928 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// LabelRequest is the set of label fields sent when creating or updating a
+// label. Empty fields are left unchanged on update.
+type LabelRequest struct {
+	Name        string `json:"name,omitempty"`
+	NewName     string `json:"new_name,omitempty"` // only for UpdateLabel
+	Color       string `json:"color,omitempty"`    // hex, without the leading "#"
+	Description string `json:"description,omitempty"`
+}
+
+// LoadLabels loads the labels defined in the repository.
+func (c *Client) LoadLabels(repo string) ([]Label, error) {
+	link := c.apiURL("repos", repo, "labels")
+	labels, err := c.loadSlice(link, Label{})
+	if err != nil {
+		return nil, err
+	}
+	return labels.([]Label), nil
+}
+
+// LoadLabels is a wrapper around DefaultClient.LoadLabels.
+func LoadLabels(repo string) ([]Label, error) {
+	return DefaultClient.LoadLabels(repo)
+}
+
+// CreateLabel creates a label in the repository.
+func (c *Client) CreateLabel(repo string, label LabelRequest) (Label, error) {
+	link := c.apiURL("repos", repo, "labels")
+	var res Label
+	if err := c.request("POST", link, label, &res); err != nil {
+		return Label{}, err
+	}
+	return res, nil
+}
+
+// CreateLabel is a wrapper around DefaultClient.CreateLabel.
+func CreateLabel(repo string, label LabelRequest) (Label, error) {
+	return DefaultClient.CreateLabel(repo, label)
+}
+
+// UpdateLabel changes the named label. Set NewName in the request to
+// rename it; the label stays on the issues it is applied to.
+func (c *Client) UpdateLabel(repo, name string, label LabelRequest) (Label, error) {
+	seg, err := pathSegment("label name", name)
+	if err != nil {
+		return Label{}, err
+	}
+	link := c.apiURL("repos", repo, "labels", seg)
+	var res Label
+	if err := c.request("PATCH", link, label, &res); err != nil {
+		return Label{}, err
+	}
+	return res, nil
+}
+
+// UpdateLabel is a wrapper around DefaultClient.UpdateLabel.
+func UpdateLabel(repo, name string, label LabelRequest) (Label, error) {
+	return DefaultClient.UpdateLabel(repo, name, label)
+}
+
+// DeleteLabel deletes the named label, removing it from all issues.
+func (c *Client) DeleteLabel(repo, name string) error {
+	seg, err := pathSegment("label name", name)
+	if err != nil {
+		return err
+	}
+	link := c.apiURL("repos", repo, "labels", seg)
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteLabel is a wrapper around DefaultClient.DeleteLabel.
+func DeleteLabel(repo, name string) error {
+	return DefaultClient.DeleteLabel(repo, name)
+}
+
+// AddLabelsToIssue adds the named labels to the issue or pull request,
+// returning all its labels.
+func (c *Client) AddLabelsToIssue(repo string, number int, labels ...string) ([]Label, error) {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "labels")
+	var res []Label
+	if err := c.request("POST", link, map[string][]string{"labels": labels}, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddLabelsToIssue is a wrapper around DefaultClient.AddLabelsToIssue.
+func AddLabelsToIssue(repo string, number int, labels ...string) ([]Label, error) {
+	return DefaultClient.AddLabelsToIssue(repo, number, labels...)
+}
+
+// RemoveLabelFromIssue removes the named label from the issue or pull
+// request, returning its remaining labels.
+func (c *Client) RemoveLabelFromIssue(repo string, number int, name string) ([]Label, error) {
+	seg, err := pathSegment("label name", name)
+	if err != nil {
+		return nil, err
+	}
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "labels", seg)
+	var res []Label
+	if err := c.request("DELETE", link, nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RemoveLabelFromIssue is a wrapper around DefaultClient.RemoveLabelFromIssue.
+func RemoveLabelFromIssue(repo string, number int, name string) ([]Label, error) {
+	return DefaultClient.RemoveLabelFromIssue(repo, number, name)
+}
@@ -0,0 +1,39 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLabelNameTraversal(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+	c := &Client{BaseURL: srv.URL}
+
+	for _, name := range []string{"", ".", ".."} {
+		if err := c.DeleteLabel("o/r", name); err == nil {
+			t.Errorf("DeleteLabel(%q) succeeded", name)
+		}
+		if _, err := c.UpdateLabel("o/r", name, LabelRequest{}); err == nil {
+			t.Errorf("UpdateLabel(%q) succeeded", name)
+		}
+		if _, err := c.RemoveLabelFromIssue("o/r", 1, name); err == nil {
+			t.Errorf("RemoveLabelFromIssue(%q) succeeded", name)
+		}
+	}
+	if len(requests) != 0 {
+		t.Fatalf("requests sent for invalid label names: %q", requests)
+	}
+
+	if err := c.DeleteLabel("o/r", "area/.."); err != nil {
+		t.Fatal(err)
+	}
+	if want := "DELETE /repos/o/r/labels/area%2F.."; len(requests) != 1 || requests[0] != want {
+		t.Errorf("got %q, want %q", requests, want)
+	}
+}
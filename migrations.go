@@ -0,0 +1,127 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Migration struct {
+	ID                 int64        `json:"id"`
+	GUID               string       `json:"guid"`
+	State              string       `json:"state"` // "pending", "exporting", "exported" or "failed"
+	LockRepositories   bool         `json:"lock_repositories"`
+	ExcludeAttachments bool         `json:"exclude_attachments"`
+	Repositories       []Repository `json:"repositories"`
+	URL                string       `json:"url"`
+	Created            time.Time    `json:"created_at"`
+	Updated            time.Time    `json:"updated_at"`
+}
+
+// MigrationRequest describes what an organization migration archive
+// should contain.
+type MigrationRequest struct {
+	Repositories       []string `json:"repositories"` // "owner/name"
+	LockRepositories   bool     `json:"lock_repositories,omitempty"`
+	ExcludeAttachments bool     `json:"exclude_attachments,omitempty"`
+	ExcludeReleases    bool     `json:"exclude_releases,omitempty"`
+	ExcludeMetadata    bool     `json:"exclude_metadata,omitempty"`
+	ExcludeGitData     bool     `json:"exclude_git_data,omitempty"`
+}
+
+// StartMigration starts exporting the given repositories of the
+// organization into a migration archive.
+func (c *Client) StartMigration(org string, mig MigrationRequest) (Migration, error) {
+	link := c.apiURL("orgs", org, "migrations")
+	var res Migration
+	if err := c.request("POST", link, mig, &res); err != nil {
+		return Migration{}, err
+	}
+	return res, nil
+}
+
+// StartMigration is a wrapper around DefaultClient.StartMigration.
+func StartMigration(org string, mig MigrationRequest) (Migration, error) {
+	return DefaultClient.StartMigration(org, mig)
+}
+
+// LoadMigrations loads the recent migrations of the organization.
+func (c *Client) LoadMigrations(org string) ([]Migration, error) {
+	link := c.apiURL("orgs", org, "migrations")
+	migs, err := c.loadSlice(link, Migration{})
+	if err != nil {
+		return nil, err
+	}
+	return migs.([]Migration), nil
+}
+
+// LoadMigrations is a wrapper around DefaultClient.LoadMigrations.
+func LoadMigrations(org string) ([]Migration, error) {
+	return DefaultClient.LoadMigrations(org)
+}
+
+// LoadMigration loads the current state of the migration.
+func (c *Client) LoadMigration(org string, id int64) (Migration, error) {
+	link := c.apiURL("orgs", org, "migrations", strconv.FormatInt(id, 10))
+	var mig Migration
+	if err := c.requestInto(link, &mig); err != nil {
+		return Migration{}, err
+	}
+	return mig, nil
+}
+
+// LoadMigration is a wrapper around DefaultClient.LoadMigration.
+func LoadMigration(org string, id int64) (Migration, error) {
+	return DefaultClient.LoadMigration(org, id)
+}
+
+// WaitMigration polls the migration every interval until it has either
+// been exported or failed. An error is returned for failed migrations, or
+// if the client's context is cancelled while waiting.
+func (c *Client) WaitMigration(org string, id int64, interval time.Duration) (Migration, error) {
+	for {
+		mig, err := c.LoadMigration(org, id)
+		if err != nil {
+			return mig, err
+		}
+		switch mig.State {
+		case "exported":
+			return mig, nil
+		case "failed":
+			return mig, fmt.Errorf("migration %d failed", id)
+		}
+		if err := c.sleep(interval); err != nil {
+			return mig, err
+		}
+	}
+}
+
+// WaitMigration is a wrapper around DefaultClient.WaitMigration.
+func WaitMigration(org string, id int64, interval time.Duration) (Migration, error) {
+	return DefaultClient.WaitMigration(org, id, interval)
+}
+
+// DownloadMigrationArchive streams the archive of an exported migration
+// to w, returning the archive size.
+func (c *Client) DownloadMigrationArchive(org string, id int64, w io.Writer) (int64, error) {
+	link := c.apiURL("orgs", org, "migrations", strconv.FormatInt(id, 10), "archive")
+	return c.download(link, w)
+}
+
+// DownloadMigrationArchive is a wrapper around DefaultClient.DownloadMigrationArchive.
+func DownloadMigrationArchive(org string, id int64, w io.Writer) (int64, error) {
+	return DefaultClient.DownloadMigrationArchive(org, id, w)
+}
+
+// DeleteMigrationArchive deletes the archive of the migration. Archives
+// are otherwise deleted automatically after seven days.
+func (c *Client) DeleteMigrationArchive(org string, id int64) error {
+	link := c.apiURL("orgs", org, "migrations", strconv.FormatInt(id, 10), "archive")
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteMigrationArchive is a wrapper around DefaultClient.DeleteMigrationArchive.
+func DeleteMigrationArchive(org string, id int64) error {
+	return DefaultClient.DeleteMigrationArchive(org, id)
+}
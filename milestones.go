@@ -0,0 +1,68 @@
+package github
+
+import (
+	"strconv"
+	"time"
+)
+
+// MilestoneRequest is the set of milestone fields sent when creating or
+// updating a milestone. Empty fields are left unchanged on update.
+type MilestoneRequest struct {
+	Title       string     `json:"title,omitempty"`
+	State       string     `json:"state,omitempty"` // "open" or "closed"
+	Description string     `json:"description,omitempty"`
+	Due         *time.Time `json:"due_on,omitempty"`
+}
+
+// CreateMilestone creates a milestone in the repository.
+func (c *Client) CreateMilestone(repo string, milestone MilestoneRequest) (Milestone, error) {
+	link := c.apiURL("repos", repo, "milestones")
+	var res Milestone
+	if err := c.request("POST", link, milestone, &res); err != nil {
+		return Milestone{}, err
+	}
+	return res, nil
+}
+
+// CreateMilestone is a wrapper around DefaultClient.CreateMilestone.
+func CreateMilestone(repo string, milestone MilestoneRequest) (Milestone, error) {
+	return DefaultClient.CreateMilestone(repo, milestone)
+}
+
+// UpdateMilestone changes the milestone fields set in the request,
+// returning the updated milestone.
+func (c *Client) UpdateMilestone(repo string, number int, milestone MilestoneRequest) (Milestone, error) {
+	link := c.apiURL("repos", repo, "milestones", strconv.Itoa(number))
+	var res Milestone
+	if err := c.request("PATCH", link, milestone, &res); err != nil {
+		return Milestone{}, err
+	}
+	return res, nil
+}
+
+// UpdateMilestone is a wrapper around DefaultClient.UpdateMilestone.
+func UpdateMilestone(repo string, number int, milestone MilestoneRequest) (Milestone, error) {
+	return DefaultClient.UpdateMilestone(repo, number, milestone)
+}
+
+// CloseMilestone closes the milestone.
+func (c *Client) CloseMilestone(repo string, number int) (Milestone, error) {
+	return c.UpdateMilestone(repo, number, MilestoneRequest{State: "closed"})
+}
+
+// CloseMilestone is a wrapper around DefaultClient.CloseMilestone.
+func CloseMilestone(repo string, number int) (Milestone, error) {
+	return DefaultClient.CloseMilestone(repo, number)
+}
+
+// DeleteMilestone deletes the milestone. Its issues are left without a
+// milestone.
+func (c *Client) DeleteMilestone(repo string, number int) error {
+	link := c.apiURL("repos", repo, "milestones", strconv.Itoa(number))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteMilestone is a wrapper around DefaultClient.DeleteMilestone.
+func DeleteMilestone(repo string, number int) error {
+	return DefaultClient.DeleteMilestone(repo, number)
+}
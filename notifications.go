@@ -0,0 +1,275 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotificationSubject is the object a notification refers to. Depending on
+// the subject type exactly one of Issue, PullRequest or Release is set.
+// LatestComment is set when the most recent activity was a comment.
+type NotificationSubject struct {
+	Issue         *Issue
+	PullRequest   *PullRequest
+	Release       *Release
+	LatestComment *Comment
+}
+
+// ResolveNotification loads the issue, pull request or release that the
+// notification subject points at, along with the latest comment on it.
+func (c *Client) ResolveNotification(n Notification) (NotificationSubject, error) {
+	var subj NotificationSubject
+	var err error
+	switch n.Subject.Type {
+	case "Issue":
+		subj.Issue = new(Issue)
+		err = c.requestInto(n.Subject.URL, subj.Issue)
+	case "PullRequest":
+		subj.PullRequest = new(PullRequest)
+		err = c.requestInto(n.Subject.URL, subj.PullRequest)
+	case "Release":
+		subj.Release = new(Release)
+		err = c.requestInto(n.Subject.URL, subj.Release)
+	default:
+		return subj, fmt.Errorf("unsupported notification subject type %q", n.Subject.Type)
+	}
+	if err != nil {
+		return subj, err
+	}
+
+	// The latest comment URL points at the subject itself when there is
+	// no comment activity.
+	if strings.Contains(n.Subject.LatestCommentURL, "/comments/") {
+		subj.LatestComment = new(Comment)
+		if err := c.requestInto(n.Subject.LatestCommentURL, subj.LatestComment); err != nil {
+			return subj, err
+		}
+	}
+
+	return subj, nil
+}
+
+// ResolveNotification is a wrapper around DefaultClient.ResolveNotification.
+func ResolveNotification(n Notification) (NotificationSubject, error) {
+	return DefaultClient.ResolveNotification(n)
+}
+
+// MarkNotificationRead marks the notification thread as read.
+func (c *Client) MarkNotificationRead(id string) error {
+	link := c.apiURL("notifications/threads", id)
+	return c.request("PATCH", link, nil, nil)
+}
+
+// MarkNotificationRead is a wrapper around DefaultClient.MarkNotificationRead.
+func MarkNotificationRead(id string) error {
+	return DefaultClient.MarkNotificationRead(id)
+}
+
+type markReadRequest struct {
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+}
+
+func markRead(before time.Time) markReadRequest {
+	if before.IsZero() {
+		return markReadRequest{}
+	}
+	return markReadRequest{LastReadAt: &before}
+}
+
+// MarkAllNotificationsRead marks the notifications last updated before the
+// given time as read, or all notifications if the time is zero. GitHub
+// may do this asynchronously for large inboxes.
+func (c *Client) MarkAllNotificationsRead(before time.Time) error {
+	link := c.apiURL("notifications")
+	return c.request("PUT", link, markRead(before), nil)
+}
+
+// MarkAllNotificationsRead is a wrapper around DefaultClient.MarkAllNotificationsRead.
+func MarkAllNotificationsRead(before time.Time) error {
+	return DefaultClient.MarkAllNotificationsRead(before)
+}
+
+// MarkRepoNotificationsRead is like MarkAllNotificationsRead, for the
+// notifications in a single repository.
+func (c *Client) MarkRepoNotificationsRead(repo string, before time.Time) error {
+	link := c.apiURL("repos", repo, "notifications")
+	return c.request("PUT", link, markRead(before), nil)
+}
+
+// MarkRepoNotificationsRead is a wrapper around DefaultClient.MarkRepoNotificationsRead.
+func MarkRepoNotificationsRead(repo string, before time.Time) error {
+	return DefaultClient.MarkRepoNotificationsRead(repo, before)
+}
+
+// GetThreadSubscription loads the authenticated user's subscription to the
+// notification thread. Without an explicit subscription the zero
+// Subscription is returned.
+func (c *Client) GetThreadSubscription(id string) (Subscription, error) {
+	link := c.apiURL("notifications/threads", id, "subscription")
+	var sub Subscription
+	err := c.requestInto(link, &sub)
+	if errors.Is(err, ErrNotFound) {
+		return Subscription{}, nil
+	}
+	return sub, err
+}
+
+// GetThreadSubscription is a wrapper around DefaultClient.GetThreadSubscription.
+func GetThreadSubscription(id string) (Subscription, error) {
+	return DefaultClient.GetThreadSubscription(id)
+}
+
+// SetThreadSubscription subscribes to the notification thread, or with
+// ignored set mutes it until the user is mentioned or comments.
+func (c *Client) SetThreadSubscription(id string, ignored bool) (Subscription, error) {
+	link := c.apiURL("notifications/threads", id, "subscription")
+	req := struct {
+		Ignored bool `json:"ignored"`
+	}{ignored}
+	var sub Subscription
+	if err := c.request("PUT", link, req, &sub); err != nil {
+		return Subscription{}, err
+	}
+	return sub, nil
+}
+
+// SetThreadSubscription is a wrapper around DefaultClient.SetThreadSubscription.
+func SetThreadSubscription(id string, ignored bool) (Subscription, error) {
+	return DefaultClient.SetThreadSubscription(id, ignored)
+}
+
+// DeleteThreadSubscription unsubscribes from the notification thread. The
+// user is notified again if mentioned or commenting.
+func (c *Client) DeleteThreadSubscription(id string) error {
+	link := c.apiURL("notifications/threads", id, "subscription")
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteThreadSubscription is a wrapper around DefaultClient.DeleteThreadSubscription.
+func DeleteThreadSubscription(id string) error {
+	return DefaultClient.DeleteThreadSubscription(id)
+}
+
+// NotificationWatcher polls the authenticated user's notifications for
+// new and updated threads, using conditional requests that don't count
+// against the rate limit when nothing has changed, and at the pace GitHub
+// asks for with the X-Poll-Interval header.
+type NotificationWatcher struct {
+	client       *Client
+	link         string
+	lastModified string
+	seen         map[string]time.Time // thread ID to update time
+	interval     time.Duration
+}
+
+// NewNotificationWatcher returns a watcher for the notifications selected
+// by the query, as for the notifications API: "all", "participating",
+// "since" and so on. The query may be nil, for unread notifications.
+func (c *Client) NewNotificationWatcher(query url.Values) *NotificationWatcher {
+	link := c.apiURL("notifications")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	return &NotificationWatcher{
+		client:   c,
+		link:     link,
+		seen:     make(map[string]time.Time),
+		interval: defaultPollInterval,
+	}
+}
+
+// NewNotificationWatcher is a wrapper around DefaultClient.NewNotificationWatcher.
+func NewNotificationWatcher(query url.Values) *NotificationWatcher {
+	return DefaultClient.NewNotificationWatcher(query)
+}
+
+// Interval returns how long to wait before the next poll, as requested by
+// GitHub.
+func (w *NotificationWatcher) Interval() time.Duration {
+	return w.interval
+}
+
+// Poll returns the notification threads that are new or have been
+// updated since the previous poll. The first poll returns all of them.
+func (w *NotificationWatcher) Poll() ([]Notification, error) {
+	return w.poll(w.client)
+}
+
+func (w *NotificationWatcher) poll(c *Client) ([]Notification, error) {
+	req, err := c.newRequest("GET", w.link, nil)
+	if err != nil {
+		return nil, err
+	}
+	if w.lastModified != "" {
+		req.Header.Set("If-Modified-Since", w.lastModified)
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if secs, err := strconv.Atoi(resp.Header.Get("X-Poll-Interval")); err == nil && secs > 0 {
+		w.interval = time.Duration(secs) * time.Second
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, nil
+	}
+	if resp.StatusCode > 299 {
+		return nil, responseError(resp)
+	}
+
+	var notifs []Notification
+	if err := c.decodeJSON(resp.Body, &notifs); err != nil {
+		return nil, err
+	}
+	if next := parseRel(resp.Header.Get("Link"), "next"); next != "" {
+		more, err := c.loadSlice(next, Notification{})
+		if err != nil {
+			return nil, err
+		}
+		notifs = append(notifs, more.([]Notification)...)
+	}
+	w.lastModified = resp.Header.Get("Last-Modified")
+
+	var fresh []Notification
+	seen := make(map[string]time.Time, len(notifs))
+	for _, n := range notifs {
+		id := n.ID.String()
+		seen[id] = n.Updated
+		if prev, ok := w.seen[id]; !ok || n.Updated.After(prev) {
+			fresh = append(fresh, n)
+		}
+	}
+	w.seen = seen
+	return fresh, nil
+}
+
+// Run polls until the context is cancelled, sending new and updated
+// notifications on the channel. Poll errors are passed to onError if it
+// is not nil, and polling continues with backoff. The context's error is
+// returned.
+func (w *NotificationWatcher) Run(ctx context.Context, ch chan<- Notification, onError func(error)) error {
+	c := w.client.WithContext(ctx)
+	return pollLoop(ctx, w.Interval, onError, func() error {
+		notifs, err := w.poll(c)
+		if err != nil {
+			return err
+		}
+		for _, n := range notifs {
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DeviceCode is the first step of the OAuth device flow. The user enters
+// UserCode at VerificationURI to authorize the application.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"` // seconds
+	Interval        int    `json:"interval"`   // minimum seconds between polls
+}
+
+// OAuthToken is an access token issued to an OAuth app.
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"` // comma separated
+}
+
+var (
+	// ErrDeviceCodeExpired is returned when the user did not authorize the
+	// device in time.
+	ErrDeviceCodeExpired = errors.New("device code expired")
+	// ErrAccessDenied is returned when the user declined to authorize the
+	// device.
+	ErrAccessDenied = errors.New("access denied by user")
+)
+
+// oauthError is the error part of an OAuth endpoint response, which is
+// reported with a successful status code.
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// RequestDeviceCode starts the OAuth device flow for the OAuth or GitHub
+// app with the given client ID, requesting the given scopes.
+func (c *Client) RequestDeviceCode(clientID string, scopes ...string) (DeviceCode, error) {
+	form := url.Values{
+		"client_id": {clientID},
+		"scope":     {strings.Join(scopes, " ")},
+	}
+	var res struct {
+		DeviceCode
+		oauthError
+	}
+	if err := c.postForm(c.webURL("login/device/code"), form, &res); err != nil {
+		return DeviceCode{}, err
+	}
+	if res.Error != "" {
+		return DeviceCode{}, fmt.Errorf("device code: %s: %s", res.Error, res.Description)
+	}
+	return res.DeviceCode, nil
+}
+
+// RequestDeviceCode is a wrapper around DefaultClient.RequestDeviceCode.
+func RequestDeviceCode(clientID string, scopes ...string) (DeviceCode, error) {
+	return DefaultClient.RequestDeviceCode(clientID, scopes...)
+}
+
+// PollDeviceToken waits for the user to authorize the device code and
+// returns the issued token. It returns ErrAccessDenied if the user
+// declines, ErrDeviceCodeExpired if the code expires first, or the
+// context's error if the client's context is cancelled.
+func (c *Client) PollDeviceToken(clientID string, code DeviceCode) (OAuthToken, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval == 0 {
+		interval = 5 * time.Second
+	}
+	form := url.Values{
+		"client_id":   {clientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+	}
+	for {
+		if err := c.sleep(interval); err != nil {
+			return OAuthToken{}, err
+		}
+		var res struct {
+			OAuthToken
+			oauthError
+			Interval int `json:"interval"`
+		}
+		if err := c.postForm(c.webURL("login/oauth/access_token"), form, &res); err != nil {
+			return OAuthToken{}, err
+		}
+		switch res.Error {
+		case "":
+			return res.OAuthToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			// Polling too often adds five seconds to the interval.
+			interval += 5 * time.Second
+			if res.Interval > 0 {
+				interval = time.Duration(res.Interval) * time.Second
+			}
+		case "expired_token":
+			return OAuthToken{}, ErrDeviceCodeExpired
+		case "access_denied":
+			return OAuthToken{}, ErrAccessDenied
+		default:
+			return OAuthToken{}, fmt.Errorf("device token: %s: %s", res.Error, res.Description)
+		}
+	}
+}
+
+// PollDeviceToken is a wrapper around DefaultClient.PollDeviceToken.
+func PollDeviceToken(clientID string, code DeviceCode) (OAuthToken, error) {
+	return DefaultClient.PollDeviceToken(clientID, code)
+}
+
+// DeviceLogin runs the whole OAuth device flow. The prompt function is
+// called with the device code so that it can show the user where to go and
+// what to enter, after which the token is awaited.
+func (c *Client) DeviceLogin(clientID string, scopes []string, prompt func(DeviceCode) error) (OAuthToken, error) {
+	code, err := c.RequestDeviceCode(clientID, scopes...)
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	if err := prompt(code); err != nil {
+		return OAuthToken{}, err
+	}
+	return c.PollDeviceToken(clientID, code)
+}
+
+// DeviceLogin is a wrapper around DefaultClient.DeviceLogin.
+func DeviceLogin(clientID string, scopes []string, prompt func(DeviceCode) error) (OAuthToken, error) {
+	return DefaultClient.DeviceLogin(clientID, scopes, prompt)
+}
+
+// postForm posts the form to one of the OAuth endpoints, without API
+// credentials, and decodes the JSON response into v.
+func (c *Client) postForm(link string, form url.Values, v interface{}) error {
+	req, err := c.newRequest("POST", link, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return responseError(resp)
+	}
+	return c.decodeJSON(resp.Body, v)
+}
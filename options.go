@@ -0,0 +1,201 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// IssueState selects issues, pull requests or milestones by state.
+type IssueState string
+
+const (
+	IssueStateOpen   IssueState = "open"
+	IssueStateClosed IssueState = "closed"
+	IssueStateAll    IssueState = "all" // in queries only
+)
+
+func (s IssueState) Valid() bool {
+	switch s {
+	case IssueStateOpen, IssueStateClosed, IssueStateAll:
+		return true
+	}
+	return false
+}
+
+// IssueFilter selects which issues LoadMyIssues and LoadOrgIssues return.
+type IssueFilter string
+
+const (
+	IssueFilterAssigned   IssueFilter = "assigned"
+	IssueFilterCreated    IssueFilter = "created"
+	IssueFilterMentioned  IssueFilter = "mentioned"
+	IssueFilterSubscribed IssueFilter = "subscribed"
+	IssueFilterRepos      IssueFilter = "repos"
+	IssueFilterAll        IssueFilter = "all"
+)
+
+func (f IssueFilter) Valid() bool {
+	switch f {
+	case IssueFilterAssigned, IssueFilterCreated, IssueFilterMentioned, IssueFilterSubscribed, IssueFilterRepos, IssueFilterAll:
+		return true
+	}
+	return false
+}
+
+// IssueSort is the sort order of issue listings.
+type IssueSort string
+
+const (
+	IssueSortCreated  IssueSort = "created"
+	IssueSortUpdated  IssueSort = "updated"
+	IssueSortComments IssueSort = "comments"
+)
+
+func (s IssueSort) Valid() bool {
+	switch s {
+	case IssueSortCreated, IssueSortUpdated, IssueSortComments:
+		return true
+	}
+	return false
+}
+
+// MilestoneSort is the sort order of milestone listings.
+type MilestoneSort string
+
+const (
+	MilestoneSortDueOn        MilestoneSort = "due_on"
+	MilestoneSortCompleteness MilestoneSort = "completeness"
+)
+
+func (s MilestoneSort) Valid() bool {
+	return s == MilestoneSortDueOn || s == MilestoneSortCompleteness
+}
+
+// Direction is the direction of a sort order.
+type Direction string
+
+const (
+	Ascending  Direction = "asc"
+	Descending Direction = "desc"
+)
+
+func (d Direction) Valid() bool {
+	return d == Ascending || d == Descending
+}
+
+// NotificationReason is why the authenticated user received a
+// notification, as in Notification.Reason.
+type NotificationReason string
+
+const (
+	NotificationReasonApprovalRequested NotificationReason = "approval_requested"
+	NotificationReasonAssign            NotificationReason = "assign"
+	NotificationReasonAuthor            NotificationReason = "author"
+	NotificationReasonCIActivity        NotificationReason = "ci_activity"
+	NotificationReasonComment           NotificationReason = "comment"
+	NotificationReasonInvitation        NotificationReason = "invitation"
+	NotificationReasonManual            NotificationReason = "manual"
+	NotificationReasonMention           NotificationReason = "mention"
+	NotificationReasonReviewRequested   NotificationReason = "review_requested"
+	NotificationReasonSecurityAlert     NotificationReason = "security_alert"
+	NotificationReasonStateChange       NotificationReason = "state_change"
+	NotificationReasonSubscribed        NotificationReason = "subscribed"
+	NotificationReasonTeamMention       NotificationReason = "team_mention"
+)
+
+// IssueOptions builds the query for LoadIssues, LoadOrgIssues,
+// LoadMyIssues and LoadIssuesMulti. Zero values are left out of the
+// query, giving GitHub's defaults.
+type IssueOptions struct {
+	State     IssueState
+	Filter    IssueFilter // LoadMyIssues and LoadOrgIssues only
+	Labels    []string
+	Milestone string // milestone number, "*" for any or "none"
+	Assignee  string // login, "*" for any or "none"
+	Creator   string
+	Mentioned string
+	Sort      IssueSort
+	Direction Direction
+	Since     time.Time // only issues updated at or after this time
+}
+
+// Values returns the options as a query, or an error if any of the
+// typed values are invalid.
+func (o IssueOptions) Values() (url.Values, error) {
+	q := make(url.Values)
+	if o.State != "" {
+		if !o.State.Valid() {
+			return nil, fmt.Errorf("invalid issue state %q", o.State)
+		}
+		q.Set("state", string(o.State))
+	}
+	if o.Filter != "" {
+		if !o.Filter.Valid() {
+			return nil, fmt.Errorf("invalid issue filter %q", o.Filter)
+		}
+		q.Set("filter", string(o.Filter))
+	}
+	if len(o.Labels) > 0 {
+		q.Set("labels", strings.Join(o.Labels, ","))
+	}
+	setIfNotEmpty(q, "milestone", o.Milestone)
+	setIfNotEmpty(q, "assignee", o.Assignee)
+	setIfNotEmpty(q, "creator", o.Creator)
+	setIfNotEmpty(q, "mentioned", o.Mentioned)
+	if o.Sort != "" {
+		if !o.Sort.Valid() {
+			return nil, fmt.Errorf("invalid issue sort %q", o.Sort)
+		}
+		q.Set("sort", string(o.Sort))
+	}
+	if o.Direction != "" {
+		if !o.Direction.Valid() {
+			return nil, fmt.Errorf("invalid sort direction %q", o.Direction)
+		}
+		q.Set("direction", string(o.Direction))
+	}
+	if !o.Since.IsZero() {
+		q.Set("since", o.Since.UTC().Format(time.RFC3339))
+	}
+	return q, nil
+}
+
+// MilestoneOptions builds the query for LoadMilestones.
+type MilestoneOptions struct {
+	State     IssueState
+	Sort      MilestoneSort
+	Direction Direction
+}
+
+// Values returns the options as a query, or an error if any of the
+// typed values are invalid.
+func (o MilestoneOptions) Values() (url.Values, error) {
+	q := make(url.Values)
+	if o.State != "" {
+		if !o.State.Valid() {
+			return nil, fmt.Errorf("invalid milestone state %q", o.State)
+		}
+		q.Set("state", string(o.State))
+	}
+	if o.Sort != "" {
+		if !o.Sort.Valid() {
+			return nil, fmt.Errorf("invalid milestone sort %q", o.Sort)
+		}
+		q.Set("sort", string(o.Sort))
+	}
+	if o.Direction != "" {
+		if !o.Direction.Valid() {
+			return nil, fmt.Errorf("invalid sort direction %q", o.Direction)
+		}
+		q.Set("direction", string(o.Direction))
+	}
+	return q, nil
+}
+
+func setIfNotEmpty(q url.Values, key, val string) {
+	if val != "" {
+		q.Set(key, val)
+	}
+}
@@ -0,0 +1,185 @@
+package github
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// LoadOrgMembers loads the members of the organization. The query may set
+// "filter" to "2fa_disabled" and "role" to "admin" or "member".
+func (c *Client) LoadOrgMembers(org string, query url.Values) ([]User, error) {
+	link := c.apiURL("orgs", org, "members")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadOrgMembers is a wrapper around DefaultClient.LoadOrgMembers.
+func LoadOrgMembers(org string, query url.Values) ([]User, error) {
+	return DefaultClient.LoadOrgMembers(org, query)
+}
+
+// Membership is a user's membership of an organization.
+type Membership struct {
+	URL   string `json:"url"`
+	State string `json:"state"` // "active" or "pending"
+	Role  string `json:"role"`  // "admin" or "member"
+	User  User   `json:"user"`
+}
+
+// GetOrgMembership loads the user's membership of the organization.
+func (c *Client) GetOrgMembership(org, username string) (Membership, error) {
+	link := c.apiURL("orgs", org, "memberships", username)
+	var res Membership
+	if err := c.requestInto(link, &res); err != nil {
+		return Membership{}, err
+	}
+	return res, nil
+}
+
+// GetOrgMembership is a wrapper around DefaultClient.GetOrgMembership.
+func GetOrgMembership(org, username string) (Membership, error) {
+	return DefaultClient.GetOrgMembership(org, username)
+}
+
+// SetOrgMembership sets the role ("admin" or "member") of a member of the
+// organization. Users that are not members are invited, and their
+// membership is pending until they accept.
+func (c *Client) SetOrgMembership(org, username, role string) (Membership, error) {
+	link := c.apiURL("orgs", org, "memberships", username)
+	var res Membership
+	if err := c.request("PUT", link, map[string]string{"role": role}, &res); err != nil {
+		return Membership{}, err
+	}
+	return res, nil
+}
+
+// SetOrgMembership is a wrapper around DefaultClient.SetOrgMembership.
+func SetOrgMembership(org, username, role string) (Membership, error) {
+	return DefaultClient.SetOrgMembership(org, username, role)
+}
+
+// RemoveOrgMembership removes the user from the organization, or cancels
+// their pending invitation. The user loses access to the organization's
+// repositories.
+func (c *Client) RemoveOrgMembership(org, username string) error {
+	link := c.apiURL("orgs", org, "memberships", username)
+	return c.request("DELETE", link, nil, nil)
+}
+
+// RemoveOrgMembership is a wrapper around DefaultClient.RemoveOrgMembership.
+func RemoveOrgMembership(org, username string) error {
+	return DefaultClient.RemoveOrgMembership(org, username)
+}
+
+// LoadOutsideCollaborators loads the users who have access to
+// repositories in the organization without being members of it. The query
+// may set "filter" to "2fa_disabled".
+func (c *Client) LoadOutsideCollaborators(org string, query url.Values) ([]User, error) {
+	link := c.apiURL("orgs", org, "outside_collaborators")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadOutsideCollaborators is a wrapper around DefaultClient.LoadOutsideCollaborators.
+func LoadOutsideCollaborators(org string, query url.Values) ([]User, error) {
+	return DefaultClient.LoadOutsideCollaborators(org, query)
+}
+
+// ConvertToOutsideCollaborator removes the user from the organization
+// membership, keeping their access to the organization's repositories as
+// an outside collaborator.
+func (c *Client) ConvertToOutsideCollaborator(org, username string) error {
+	link := c.apiURL("orgs", org, "outside_collaborators", username)
+	return c.request("PUT", link, nil, nil)
+}
+
+// ConvertToOutsideCollaborator is a wrapper around DefaultClient.ConvertToOutsideCollaborator.
+func ConvertToOutsideCollaborator(org, username string) error {
+	return DefaultClient.ConvertToOutsideCollaborator(org, username)
+}
+
+// RemoveOutsideCollaborator removes the outside collaborator from all of
+// the organization's repositories.
+func (c *Client) RemoveOutsideCollaborator(org, username string) error {
+	link := c.apiURL("orgs", org, "outside_collaborators", username)
+	return c.request("DELETE", link, nil, nil)
+}
+
+// RemoveOutsideCollaborator is a wrapper around DefaultClient.RemoveOutsideCollaborator.
+func RemoveOutsideCollaborator(org, username string) error {
+	return DefaultClient.RemoveOutsideCollaborator(org, username)
+}
+
+// Invitation is a pending invitation to join an organization.
+type Invitation struct {
+	ID        int       `json:"id"`
+	Login     string    `json:"login"` // empty for invitations by email
+	Email     string    `json:"email"`
+	Role      string    `json:"role"` // "direct_member", "admin", "billing_manager", ...
+	Inviter   User      `json:"inviter"`
+	TeamCount int       `json:"team_count"`
+	Created   time.Time `json:"created_at"`
+}
+
+// InvitationRequest invites a user, by ID or by email address, to the
+// organization.
+type InvitationRequest struct {
+	InviteeID int    `json:"invitee_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Role      string `json:"role,omitempty"` // "direct_member" (default), "admin" or "billing_manager"
+	TeamIDs   []int  `json:"team_ids,omitempty"`
+}
+
+// LoadInvitations loads the pending invitations to the organization.
+func (c *Client) LoadInvitations(org string) ([]Invitation, error) {
+	link := c.apiURL("orgs", org, "invitations")
+	invs, err := c.loadSlice(link, Invitation{})
+	if err != nil {
+		return nil, err
+	}
+	return invs.([]Invitation), nil
+}
+
+// LoadInvitations is a wrapper around DefaultClient.LoadInvitations.
+func LoadInvitations(org string) ([]Invitation, error) {
+	return DefaultClient.LoadInvitations(org)
+}
+
+// CreateInvitation invites a user to the organization.
+func (c *Client) CreateInvitation(org string, inv InvitationRequest) (Invitation, error) {
+	link := c.apiURL("orgs", org, "invitations")
+	var res Invitation
+	if err := c.request("POST", link, inv, &res); err != nil {
+		return Invitation{}, err
+	}
+	return res, nil
+}
+
+// CreateInvitation is a wrapper around DefaultClient.CreateInvitation.
+func CreateInvitation(org string, inv InvitationRequest) (Invitation, error) {
+	return DefaultClient.CreateInvitation(org, inv)
+}
+
+// CancelInvitation cancels a pending invitation to the organization.
+func (c *Client) CancelInvitation(org string, invitationID int) error {
+	link := c.apiURL("orgs", org, "invitations", strconv.Itoa(invitationID))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// CancelInvitation is a wrapper around DefaultClient.CancelInvitation.
+func CancelInvitation(org string, invitationID int) error {
+	return DefaultClient.CancelInvitation(org, invitationID)
+}
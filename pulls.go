@@ -0,0 +1,213 @@
+package github
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type PullRequest struct {
+	ID        int            `json:"id"`
+	URL       string         `json:"url"`
+	HTMLURL   string         `json:"html_url"`
+	Number    int            `json:"number"`
+	State     string         `json:"state"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	User      User           `json:"user"`
+	Labels    []Label        `json:"labels"`
+	Assignee  User           `json:"assignee"`  // zero if unassigned
+	Milestone Milestone      `json:"milestone"` // zero if not in a milestone
+	Head      PullRequestRef `json:"head"`
+	Base      PullRequestRef `json:"base"`
+	Draft     bool           `json:"draft"`
+	Merged    bool           `json:"merged"`
+	MergedAt  *time.Time     `json:"merged_at"` // nil for unmerged pull requests
+	Closed    *time.Time     `json:"closed_at"` // nil for open pull requests
+	Created   time.Time      `json:"created_at"`
+	Updated   time.Time      `json:"updated_at"`
+
+	RequestedReviewers []User `json:"requested_reviewers"`
+	RequestedTeams     []Team `json:"requested_teams"`
+
+	// Only present when loading a single pull request. Mergeable is nil
+	// while GitHub is computing it in the background.
+	Assignees      []User `json:"assignees"`
+	MergedBy       *User  `json:"merged_by"`
+	MergeCommitSHA string `json:"merge_commit_sha"`
+	Mergeable      *bool  `json:"mergeable"`
+	MergeableState string `json:"mergeable_state"` // "clean", "dirty", "blocked", "behind", "unstable", ...
+	Comments       int    `json:"comments"`
+	ReviewComments int    `json:"review_comments"`
+	Commits        int    `json:"commits"`
+	Additions      int    `json:"additions"`
+	Deletions      int    `json:"deletions"`
+	ChangedFiles   int    `json:"changed_files"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+type PullRequestRef struct {
+	Label string      `json:"label"`
+	Ref   string      `json:"ref"`
+	SHA   string      `json:"sha"`
+	User  User        `json:"user"`
+	Repo  *Repository `json:"repo"` // nil if the repository has been deleted
+}
+
+func (p PullRequest) BodyHTML() template.HTML {
+	return renderMarkdown(p.Body)
+}
+
+// LoadPullRequests loads the pull requests of the repository. The query
+// takes the "state", "head", "base", "sort" and "direction" parameters.
+func (c *Client) LoadPullRequests(repo string, query url.Values) ([]PullRequest, error) {
+	link := c.apiURL("repos", repo, "pulls")
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	prs, err := c.loadSlice(link, PullRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return prs.([]PullRequest), nil
+}
+
+// LoadPullRequests is a wrapper around DefaultClient.LoadPullRequests.
+func LoadPullRequests(repo string, query url.Values) ([]PullRequest, error) {
+	return DefaultClient.LoadPullRequests(repo, query)
+}
+
+// LoadPullRequest loads a single pull request, including its
+// mergeability and change statistics.
+func (c *Client) LoadPullRequest(repo string, number int) (PullRequest, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number))
+	var pr PullRequest
+	if err := c.requestInto(link, &pr); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// LoadPullRequest is a wrapper around DefaultClient.LoadPullRequest.
+func LoadPullRequest(repo string, number int) (PullRequest, error) {
+	return DefaultClient.LoadPullRequest(repo, number)
+}
+
+// LoadCommitPullRequests loads the pull requests associated with the
+// commit: the pull request that merged it, or open pull requests
+// containing it.
+func (c *Client) LoadCommitPullRequests(repo, sha string) ([]PullRequest, error) {
+	link := c.apiURL("repos", repo, "commits", sha, "pulls")
+	prs, err := c.loadSlice(link, PullRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return prs.([]PullRequest), nil
+}
+
+// LoadCommitPullRequests is a wrapper around DefaultClient.LoadCommitPullRequests.
+func LoadCommitPullRequests(repo, sha string) ([]PullRequest, error) {
+	return DefaultClient.LoadCommitPullRequests(repo, sha)
+}
+
+// LoadPullRequestFiles loads the files changed by the pull request, with
+// their patches. At most 3000 files are listed.
+func (c *Client) LoadPullRequestFiles(repo string, number int) ([]CommitFile, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "files") + "?per_page=100"
+	files, err := c.loadSlice(link, CommitFile{})
+	if err != nil {
+		return nil, err
+	}
+	return files.([]CommitFile), nil
+}
+
+// LoadPullRequestFiles is a wrapper around DefaultClient.LoadPullRequestFiles.
+func LoadPullRequestFiles(repo string, number int) ([]CommitFile, error) {
+	return DefaultClient.LoadPullRequestFiles(repo, number)
+}
+
+// MergeMethod is how a pull request is merged.
+type MergeMethod string
+
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
+// MergeRequest describes how MergePullRequest merges a pull request.
+type MergeRequest struct {
+	Method        MergeMethod `json:"merge_method,omitempty"` // MergeMethodMerge if empty
+	CommitTitle   string      `json:"commit_title,omitempty"`
+	CommitMessage string      `json:"commit_message,omitempty"`
+	// SHA, if set, must match the head of the pull request for the merge
+	// to happen.
+	SHA string `json:"sha,omitempty"`
+}
+
+// MergeResult is the outcome of a merge.
+type MergeResult struct {
+	SHA     string `json:"sha"` // the merge commit
+	Merged  bool   `json:"merged"`
+	Message string `json:"message"`
+}
+
+// MergePullRequest merges the pull request. Pull requests that can't be
+// merged give an APIError with status 405, and a head that doesn't match
+// the request SHA one with status 409.
+func (c *Client) MergePullRequest(repo string, number int, merge MergeRequest) (MergeResult, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "merge")
+	var res MergeResult
+	if err := c.request("PUT", link, merge, &res); err != nil {
+		return MergeResult{}, err
+	}
+	return res, nil
+}
+
+// MergePullRequest is a wrapper around DefaultClient.MergePullRequest.
+func MergePullRequest(repo string, number int, merge MergeRequest) (MergeResult, error) {
+	return DefaultClient.MergePullRequest(repo, number, merge)
+}
+
+const readyForReviewMutation = `mutation($id: ID!) {
+  markPullRequestReadyForReview(input: {pullRequestId: $id}) {
+    clientMutationId
+  }
+}`
+
+const convertToDraftMutation = `mutation($id: ID!) {
+  convertPullRequestToDraft(input: {pullRequestId: $id}) {
+    clientMutationId
+  }
+}`
+
+// MarkReadyForReview takes the pull request out of draft state.
+func (c *Client) MarkReadyForReview(repo string, number int) error {
+	id, err := c.pullRequestNodeID(repo, number)
+	if err != nil {
+		return err
+	}
+	return c.Mutate(readyForReviewMutation, map[string]interface{}{"id": id}, nil)
+}
+
+// MarkReadyForReview is a wrapper around DefaultClient.MarkReadyForReview.
+func MarkReadyForReview(repo string, number int) error {
+	return DefaultClient.MarkReadyForReview(repo, number)
+}
+
+// ConvertToDraft puts the pull request back in draft state.
+func (c *Client) ConvertToDraft(repo string, number int) error {
+	id, err := c.pullRequestNodeID(repo, number)
+	if err != nil {
+		return err
+	}
+	return c.Mutate(convertToDraftMutation, map[string]interface{}{"id": id}, nil)
+}
+
+// ConvertToDraft is a wrapper around DefaultClient.ConvertToDraft.
+func ConvertToDraft(repo string, number int) error {
+	return DefaultClient.ConvertToDraft(repo, number)
+}
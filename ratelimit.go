@@ -0,0 +1,101 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateLimitSlack is added to the reset time before retrying, to allow for
+// clock differences.
+const rateLimitSlack = time.Second
+
+// secondaryRetryWait is the initial wait after a secondary rate limit
+// refusal that does not say how long to wait, as recommended by GitHub.
+// It doubles with each retry.
+const secondaryRetryWait = time.Minute
+
+// retryWait returns how long to wait before retrying the request that got
+// the response, given the number of retries already made. The boolean is
+// false if the request should not be retried.
+func (c *Client) retryWait(resp *http.Response, retries int) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	// Secondary rate limits say when to retry, or at least say what they
+	// are.
+	if wait, ok := retryAfter(resp.Header); ok {
+		return wait, retries < c.MaxRetries
+	}
+
+	rate, _, ok := parseRate(resp.Header)
+	if ok && rate.Remaining == 0 {
+		wait := time.Until(rate.Reset) + rateLimitSlack
+		return wait, c.MaxRateLimitWait > 0 && wait <= c.MaxRateLimitWait
+	}
+
+	if retries < c.MaxRetries && secondaryLimited(resp) {
+		return secondaryRetryWait << uint(retries), true
+	}
+	return 0, false
+}
+
+// retryAfter returns the delay given in the Retry-After header, which is
+// either a number of seconds or a date.
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// secondaryLimited returns true if the response body reports a secondary
+// rate limit. The body is left intact for the caller.
+func secondaryLimited(resp *http.Response) bool {
+	bs, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(bs), resp.Body), resp.Body}
+	return isSecondaryLimit(string(bs))
+}
+
+func isSecondaryLimit(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "secondary rate limit") || strings.Contains(msg, "abuse detection")
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// canRetry returns true if the request can be sent again, which requires
+// a way to recreate its body.
+func canRetry(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind returns a copy of the request with a fresh body, for sending
+// again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
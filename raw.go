@@ -0,0 +1,61 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"reflect"
+)
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// decodeJSON decodes the JSON from r into v, retaining the raw JSON if
+// the client's RetainRawJSON is set.
+func (c *Client) decodeJSON(r io.Reader, v interface{}) error {
+	if !c.RetainRawJSON {
+		return json.NewDecoder(r).Decode(v)
+	}
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return c.unmarshalJSON(bs, v)
+}
+
+// unmarshalJSON is like json.Unmarshal, but retains the raw JSON of v, or
+// of each element of v if it is a slice, if the client's RetainRawJSON is
+// set.
+func (c *Client) unmarshalJSON(bs []byte, v interface{}) error {
+	if err := json.Unmarshal(bs, v); err != nil {
+		return err
+	}
+	if !c.RetainRawJSON {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	switch rv.Kind() {
+	case reflect.Struct:
+		setRaw(rv, bs)
+	case reflect.Slice:
+		var raws []json.RawMessage
+		if err := json.Unmarshal(bs, &raws); err != nil {
+			return err
+		}
+		for i := 0; i < len(raws) && i < rv.Len(); i++ {
+			setRaw(rv.Index(i), raws[i])
+		}
+	}
+	return nil
+}
+
+// setRaw sets the Raw field of the struct v, if there is one.
+func setRaw(v reflect.Value, raw []byte) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	f := v.FieldByName("Raw")
+	if f.IsValid() && f.Type() == rawMessageType && f.CanSet() {
+		f.SetBytes(append([]byte(nil), raw...))
+	}
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"strconv"
+	"time"
+)
+
+// Reactions is the reaction summary included on issues and comments.
+type Reactions struct {
+	TotalCount int `json:"total_count"`
+	PlusOne    int `json:"+1"`
+	MinusOne   int `json:"-1"`
+	Laugh      int `json:"laugh"`
+	Hooray     int `json:"hooray"`
+	Confused   int `json:"confused"`
+	Heart      int `json:"heart"`
+	Rocket     int `json:"rocket"`
+	Eyes       int `json:"eyes"`
+}
+
+// Reaction is a single reaction by a user.
+type Reaction struct {
+	ID      int64     `json:"id"`
+	User    User      `json:"user"`
+	Content string    `json:"content"` // "+1", "-1", "laugh", "confused", "heart", "hooray", "rocket" or "eyes"
+	Created time.Time `json:"created_at"`
+}
+
+// LoadIssueReactions loads the reactions to an issue or pull request.
+func (c *Client) LoadIssueReactions(repo string, number int) ([]Reaction, error) {
+	return c.loadReactions(c.apiURL("repos", repo, "issues", strconv.Itoa(number), "reactions"))
+}
+
+// LoadIssueReactions is a wrapper around DefaultClient.LoadIssueReactions.
+func LoadIssueReactions(repo string, number int) ([]Reaction, error) {
+	return DefaultClient.LoadIssueReactions(repo, number)
+}
+
+// LoadCommentReactions loads the reactions to an issue comment.
+func (c *Client) LoadCommentReactions(repo string, commentID int) ([]Reaction, error) {
+	return c.loadReactions(c.apiURL("repos", repo, "issues/comments", strconv.Itoa(commentID), "reactions"))
+}
+
+// LoadCommentReactions is a wrapper around DefaultClient.LoadCommentReactions.
+func LoadCommentReactions(repo string, commentID int) ([]Reaction, error) {
+	return DefaultClient.LoadCommentReactions(repo, commentID)
+}
+
+// CreateIssueReaction reacts to an issue or pull request. Reacting twice
+// with the same content returns the existing reaction.
+func (c *Client) CreateIssueReaction(repo string, number int, content string) (Reaction, error) {
+	return c.createReaction(c.apiURL("repos", repo, "issues", strconv.Itoa(number), "reactions"), content)
+}
+
+// CreateIssueReaction is a wrapper around DefaultClient.CreateIssueReaction.
+func CreateIssueReaction(repo string, number int, content string) (Reaction, error) {
+	return DefaultClient.CreateIssueReaction(repo, number, content)
+}
+
+// CreateCommentReaction reacts to an issue comment.
+func (c *Client) CreateCommentReaction(repo string, commentID int, content string) (Reaction, error) {
+	return c.createReaction(c.apiURL("repos", repo, "issues/comments", strconv.Itoa(commentID), "reactions"), content)
+}
+
+// CreateCommentReaction is a wrapper around DefaultClient.CreateCommentReaction.
+func CreateCommentReaction(repo string, commentID int, content string) (Reaction, error) {
+	return DefaultClient.CreateCommentReaction(repo, commentID, content)
+}
+
+// DeleteIssueReaction deletes a reaction to an issue or pull request.
+func (c *Client) DeleteIssueReaction(repo string, number int, reactionID int64) error {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "reactions", strconv.FormatInt(reactionID, 10))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteIssueReaction is a wrapper around DefaultClient.DeleteIssueReaction.
+func DeleteIssueReaction(repo string, number int, reactionID int64) error {
+	return DefaultClient.DeleteIssueReaction(repo, number, reactionID)
+}
+
+// DeleteCommentReaction deletes a reaction to an issue comment.
+func (c *Client) DeleteCommentReaction(repo string, commentID int, reactionID int64) error {
+	link := c.apiURL("repos", repo, "issues/comments", strconv.Itoa(commentID), "reactions", strconv.FormatInt(reactionID, 10))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteCommentReaction is a wrapper around DefaultClient.DeleteCommentReaction.
+func DeleteCommentReaction(repo string, commentID int, reactionID int64) error {
+	return DefaultClient.DeleteCommentReaction(repo, commentID, reactionID)
+}
+
+func (c *Client) loadReactions(link string) ([]Reaction, error) {
+	reactions, err := c.loadSlice(link, Reaction{})
+	if err != nil {
+		return nil, err
+	}
+	return reactions.([]Reaction), nil
+}
+
+func (c *Client) createReaction(link, content string) (Reaction, error) {
+	var res Reaction
+	if err := c.request("POST", link, map[string]string{"content": content}, &res); err != nil {
+		return Reaction{}, err
+	}
+	return res, nil
+}
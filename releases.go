@@ -0,0 +1,138 @@
+package github
+
+import (
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+)
+
+// ReleaseRequest is the set of release fields sent when creating or
+// updating a release. Empty fields are left unchanged on update.
+type ReleaseRequest struct {
+	TagName         string `json:"tag_name,omitempty"`
+	TargetCommitish string `json:"target_commitish,omitempty"` // branch or commit to tag, if the tag does not exist
+	Name            string `json:"name,omitempty"`
+	Body            string `json:"body,omitempty"`
+	Draft           *bool  `json:"draft,omitempty"`
+	Prerelease      *bool  `json:"prerelease,omitempty"`
+	MakeLatest      string `json:"make_latest,omitempty"` // "true", "false" or "legacy"
+
+	// GenerateReleaseNotes fills in the name and body from the changes
+	// since the previous release. A given body is prepended to the
+	// generated notes. Only for CreateRelease.
+	GenerateReleaseNotes bool `json:"generate_release_notes,omitempty"`
+}
+
+// LoadLatestRelease loads the latest published full release of the
+// repository, as marked on GitHub. Drafts and prereleases are never the
+// latest release.
+func (c *Client) LoadLatestRelease(repo string) (Release, error) {
+	link := c.apiURL("repos", repo, "releases/latest")
+	var rel Release
+	if err := c.requestInto(link, &rel); err != nil {
+		return Release{}, err
+	}
+	return rel, nil
+}
+
+// LoadLatestRelease is a wrapper around DefaultClient.LoadLatestRelease.
+func LoadLatestRelease(repo string) (Release, error) {
+	return DefaultClient.LoadLatestRelease(repo)
+}
+
+// LoadReleaseByTag loads the published release for the given tag.
+func (c *Client) LoadReleaseByTag(repo, tag string) (Release, error) {
+	seg, err := pathSegment("tag", tag)
+	if err != nil {
+		return Release{}, err
+	}
+	link := c.apiURL("repos", repo, "releases/tags", seg)
+	var rel Release
+	if err := c.requestInto(link, &rel); err != nil {
+		return Release{}, err
+	}
+	return rel, nil
+}
+
+// LoadReleaseByTag is a wrapper around DefaultClient.LoadReleaseByTag.
+func LoadReleaseByTag(repo, tag string) (Release, error) {
+	return DefaultClient.LoadReleaseByTag(repo, tag)
+}
+
+// CreateRelease creates a release, and the tag if it does not exist.
+func (c *Client) CreateRelease(repo string, rel ReleaseRequest) (Release, error) {
+	link := c.apiURL("repos", repo, "releases")
+	var res Release
+	if err := c.request("POST", link, rel, &res); err != nil {
+		return Release{}, err
+	}
+	return res, nil
+}
+
+// CreateRelease is a wrapper around DefaultClient.CreateRelease.
+func CreateRelease(repo string, rel ReleaseRequest) (Release, error) {
+	return DefaultClient.CreateRelease(repo, rel)
+}
+
+// UpdateRelease changes the release fields set in the request, returning
+// the updated release. Publish a draft by setting Draft to false.
+func (c *Client) UpdateRelease(repo string, id int, rel ReleaseRequest) (Release, error) {
+	link := c.apiURL("repos", repo, "releases", strconv.Itoa(id))
+	var res Release
+	if err := c.request("PATCH", link, rel, &res); err != nil {
+		return Release{}, err
+	}
+	return res, nil
+}
+
+// UpdateRelease is a wrapper around DefaultClient.UpdateRelease.
+func UpdateRelease(repo string, id int, rel ReleaseRequest) (Release, error) {
+	return DefaultClient.UpdateRelease(repo, id, rel)
+}
+
+// DeleteRelease deletes the release and its assets. The tag is left in
+// place.
+func (c *Client) DeleteRelease(repo string, id int) error {
+	link := c.apiURL("repos", repo, "releases", strconv.Itoa(id))
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteRelease is a wrapper around DefaultClient.DeleteRelease.
+func DeleteRelease(repo string, id int) error {
+	return DefaultClient.DeleteRelease(repo, id)
+}
+
+// UploadReleaseAsset uploads size bytes from r as a release asset with
+// the given file name and content type, returning the new asset. The data
+// is streamed, not held in memory. Uploads are not retried on rate
+// limiting, as the reader cannot be rewound.
+func (c *Client) UploadReleaseAsset(repo string, releaseID int, name, contentType string, r io.Reader, size int64) (Asset, error) {
+	link := c.uploadURL("repos", repo, "releases", strconv.Itoa(releaseID), "assets") + "?" + url.Values{"name": {name}}.Encode()
+	req, err := c.newRequest("POST", link, ioutil.NopCloser(r))
+	if err != nil {
+		return Asset{}, err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return Asset{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return Asset{}, responseError(resp)
+	}
+	var asset Asset
+	if err := c.decodeJSON(resp.Body, &asset); err != nil {
+		return Asset{}, err
+	}
+	return asset, nil
+}
+
+// UploadReleaseAsset is a wrapper around DefaultClient.UploadReleaseAsset.
+func UploadReleaseAsset(repo string, releaseID int, name, contentType string, r io.Reader, size int64) (Asset, error) {
+	return DefaultClient.UploadReleaseAsset(repo, releaseID, name, contentType, r, size)
+}
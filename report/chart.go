@@ -0,0 +1,199 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/calmh/github"
+)
+
+// BurndownPoint is the number of open issues at the end of a day.
+type BurndownPoint struct {
+	Date time.Time
+	Open int
+}
+
+// VelocityPoint is the number of issues closed during the week starting at
+// Week.
+type VelocityPoint struct {
+	Week   time.Time
+	Closed int
+}
+
+const day = 24 * time.Hour
+
+// Burndown returns the number of open issues in the milestone for each
+// day from when the milestone was created until it was closed, or until
+// today for open milestones. The issues should be all issues in the
+// milestone, open and closed.
+func Burndown(m github.Milestone, issues []github.Issue) []BurndownPoint {
+	end := time.Now()
+	if m.Closed != nil {
+		end = *m.Closed
+	}
+
+	var points []BurndownPoint
+	for d := m.Created.UTC().Truncate(day); !d.After(end); d = d.Add(day) {
+		eod := d.Add(day)
+		open := 0
+		for _, i := range issues {
+			if i.Created.Before(eod) && (i.Closed == nil || !i.Closed.Before(eod)) {
+				open++
+			}
+		}
+		points = append(points, BurndownPoint{Date: d, Open: open})
+	}
+	return points
+}
+
+// Velocity returns the number of issues closed per week, from the week of
+// the first closed issue to the week of the last one. Weeks start on
+// Monday.
+func Velocity(issues []github.Issue) []VelocityPoint {
+	counts := make(map[time.Time]int)
+	var first, last time.Time
+	for _, i := range issues {
+		if i.Closed == nil {
+			continue
+		}
+		w := weekStart(*i.Closed)
+		counts[w]++
+		if first.IsZero() || w.Before(first) {
+			first = w
+		}
+		if w.After(last) {
+			last = w
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+
+	var points []VelocityPoint
+	for w := first; !w.After(last); w = w.Add(7 * day) {
+		points = append(points, VelocityPoint{Week: w, Closed: counts[w]})
+	}
+	return points
+}
+
+func weekStart(t time.Time) time.Time {
+	t = t.UTC().Truncate(day)
+	offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+	return t.Add(-time.Duration(offset) * day)
+}
+
+// MilestoneBurndown renders the burndown chart for the milestone, based on
+// the site issues that belong to it.
+func (s Site) MilestoneBurndown(m github.Milestone) template.HTML {
+	var issues []github.Issue
+	for _, i := range s.Issues {
+		if i.Milestone.Number == m.Number {
+			issues = append(issues, i)
+		}
+	}
+	return BurndownSVG(Burndown(m, issues), 600, 200)
+}
+
+// VelocityChart renders the weekly velocity chart for the site issues.
+func (s Site) VelocityChart() template.HTML {
+	return VelocitySVG(Velocity(s.Issues), 600, 200)
+}
+
+// chart margins, in pixels
+const (
+	marginLeft   = 40
+	marginRight  = 10
+	marginTop    = 10
+	marginBottom = 25
+)
+
+// BurndownSVG renders the burndown as an SVG line chart of the given size,
+// with a dashed line for the ideal burndown from the first to the last
+// day.
+func BurndownSVG(points []BurndownPoint, width, height int) template.HTML {
+	var b strings.Builder
+	svgStart(&b, width, height)
+	if len(points) > 0 {
+		max := 1
+		for _, p := range points {
+			if p.Open > max {
+				max = p.Open
+			}
+		}
+		plotW, plotH := plotSize(width, height)
+		x := func(i int) float64 {
+			if len(points) == 1 {
+				return marginLeft
+			}
+			return marginLeft + float64(i)*plotW/float64(len(points)-1)
+		}
+		y := func(v int) float64 {
+			return marginTop + plotH - float64(v)*plotH/float64(max)
+		}
+
+		axes(&b, width, height, max)
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#959da5" stroke-dasharray="4,4"/>`,
+			x(0), y(points[0].Open), x(len(points)-1), y(0))
+		b.WriteString(`<polyline fill="none" stroke="#0366d6" stroke-width="2" points="`)
+		for i, p := range points {
+			fmt.Fprintf(&b, "%.1f,%.1f ", x(i), y(p.Open))
+		}
+		b.WriteString(`"/>`)
+		dateLabels(&b, height, x(0), x(len(points)-1), points[0].Date, points[len(points)-1].Date)
+	}
+	b.WriteString("</svg>")
+	return template.HTML(b.String())
+}
+
+// VelocitySVG renders the velocity as an SVG bar chart of the given size.
+func VelocitySVG(points []VelocityPoint, width, height int) template.HTML {
+	var b strings.Builder
+	svgStart(&b, width, height)
+	if len(points) > 0 {
+		max := 1
+		for _, p := range points {
+			if p.Closed > max {
+				max = p.Closed
+			}
+		}
+		plotW, plotH := plotSize(width, height)
+		barW := plotW / float64(len(points))
+
+		axes(&b, width, height, max)
+		for i, p := range points {
+			h := float64(p.Closed) * plotH / float64(max)
+			fmt.Fprintf(&b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="#28a745"><title>%s: %d closed</title></rect>`,
+				marginLeft+float64(i)*barW+1, marginTop+plotH-h, barW-2, h, p.Week.Format("2006-01-02"), p.Closed)
+		}
+		dateLabels(&b, height, marginLeft, marginLeft+plotW-barW, points[0].Week, points[len(points)-1].Week)
+	}
+	b.WriteString("</svg>")
+	return template.HTML(b.String())
+}
+
+func svgStart(b *strings.Builder, width, height int) {
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`, width, height, width, height)
+}
+
+func plotSize(width, height int) (float64, float64) {
+	return float64(width - marginLeft - marginRight), float64(height - marginTop - marginBottom)
+}
+
+// axes draws the x and y axes and labels the y axis with zero and max.
+func axes(b *strings.Builder, width, height, max int) {
+	bottom := height - marginBottom
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#24292e"/>`, marginLeft, marginTop, marginLeft, bottom)
+	fmt.Fprintf(b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#24292e"/>`, marginLeft, bottom, width-marginRight, bottom)
+	fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="end">%d</text>`, marginLeft-4, marginTop+8, max)
+	fmt.Fprintf(b, `<text x="%d" y="%d" text-anchor="end">0</text>`, marginLeft-4, bottom)
+}
+
+func dateLabels(b *strings.Builder, height int, x0, x1 float64, first, last time.Time) {
+	y := height - marginBottom + 15
+	fmt.Fprintf(b, `<text x="%.1f" y="%d">%s</text>`, x0, y, first.Format("Jan 2"))
+	if x1 > x0 {
+		fmt.Fprintf(b, `<text x="%.1f" y="%d" text-anchor="end">%s</text>`, x1, y, last.Format("Jan 2"))
+	}
+}
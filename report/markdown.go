@@ -0,0 +1,69 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/calmh/github"
+)
+
+// WriteIssueMarkdown writes the issue and its comments as a Markdown
+// document, with the issue metadata as YAML front matter.
+func WriteIssueMarkdown(w io.Writer, issue github.Issue, comments []github.Comment) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "---")
+	fmt.Fprintf(bw, "title: %s\n", strconv.Quote(issue.Title))
+	fmt.Fprintf(bw, "number: %d\n", issue.Number)
+	if repo := issue.Repo(); repo != "" {
+		fmt.Fprintf(bw, "repository: %s\n", strconv.Quote(repo))
+	}
+	fmt.Fprintf(bw, "type: %s\n", issue.Type())
+	fmt.Fprintf(bw, "state: %s\n", issue.State)
+	fmt.Fprintf(bw, "author: %s\n", strconv.Quote(issue.User.Login))
+	if len(issue.Labels) > 0 {
+		names := make([]string, len(issue.Labels))
+		for i, l := range issue.Labels {
+			names[i] = strconv.Quote(l.Name)
+		}
+		fmt.Fprintf(bw, "labels: [%s]\n", strings.Join(names, ", "))
+	}
+	if len(issue.Assignees) > 0 {
+		logins := make([]string, len(issue.Assignees))
+		for i, u := range issue.Assignees {
+			logins[i] = strconv.Quote(u.Login)
+		}
+		fmt.Fprintf(bw, "assignees: [%s]\n", strings.Join(logins, ", "))
+	}
+	if issue.Milestone.Title != "" {
+		fmt.Fprintf(bw, "milestone: %s\n", strconv.Quote(issue.Milestone.Title))
+	}
+	fmt.Fprintf(bw, "created: %s\n", issue.Created.UTC().Format(time.RFC3339))
+	fmt.Fprintf(bw, "updated: %s\n", issue.Updated.UTC().Format(time.RFC3339))
+	if issue.Closed != nil {
+		fmt.Fprintf(bw, "closed: %s\n", issue.Closed.UTC().Format(time.RFC3339))
+	}
+	fmt.Fprintf(bw, "url: %s\n", strconv.Quote(issue.HTMLURL))
+	fmt.Fprintln(bw, "---")
+	fmt.Fprintln(bw)
+
+	fmt.Fprintf(bw, "# %s (#%d)\n\n", issue.Title, issue.Number)
+	if body := strings.TrimSpace(issue.Body); body != "" {
+		fmt.Fprintf(bw, "%s\n\n", body)
+	}
+
+	if len(comments) > 0 {
+		fmt.Fprintln(bw, "## Comments")
+		fmt.Fprintln(bw)
+		for _, c := range comments {
+			fmt.Fprintf(bw, "### @%s on %s\n\n", c.User.Login, c.Created.UTC().Format("2006-01-02 15:04 MST"))
+			fmt.Fprintf(bw, "%s\n\n", strings.TrimSpace(c.Body))
+		}
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,85 @@
+// Package report renders issues, milestones and releases loaded with
+// package github into a static HTML site.
+package report
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/calmh/github"
+)
+
+// Site is the data for a static report site. Any of the slices may be
+// empty, in which case the corresponding page is still written.
+type Site struct {
+	Title      string
+	Issues     []github.Issue
+	Milestones []github.Milestone
+	Releases   []github.Release
+}
+
+// Write renders the site into dir as index.html, issues.html,
+// milestones.html and releases.html.
+func (s Site) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	pages := []struct {
+		file string
+		tpl  string
+	}{
+		{"index.html", "index"},
+		{"issues.html", "issues"},
+		{"milestones.html", "milestones"},
+		{"releases.html", "releases"},
+	}
+	for _, p := range pages {
+		if err := writeFile(filepath.Join(dir, p.file), p.tpl, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteIssues renders an issue list page.
+func WriteIssues(w io.Writer, title string, issues []github.Issue) error {
+	return templates.ExecuteTemplate(w, "issues", Site{Title: title, Issues: issues})
+}
+
+// WriteMilestones renders a milestone summary page.
+func WriteMilestones(w io.Writer, title string, milestones []github.Milestone) error {
+	return templates.ExecuteTemplate(w, "milestones", Site{Title: title, Milestones: milestones})
+}
+
+// WriteReleases renders a release page.
+func WriteReleases(w io.Writer, title string, releases []github.Release) error {
+	return templates.ExecuteTemplate(w, "releases", Site{Title: title, Releases: releases})
+}
+
+func writeFile(name, tpl string, data interface{}) error {
+	fd, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := templates.ExecuteTemplate(fd, tpl, data); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+var templates = template.Must(template.New("report").Funcs(github.FuncMap()).Funcs(funcs).Parse(layoutTpl + indexTpl + issuesTpl + milestonesTpl + releasesTpl))
+
+var funcs = template.FuncMap{
+	"add": func(a, b int) int {
+		return a + b
+	},
+	"percent": func(part, total int) int {
+		if total == 0 {
+			return 0
+		}
+		return 100 * part / total
+	},
+}
@@ -0,0 +1,91 @@
+package report
+
+const layoutTpl = `
+{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, Helvetica, Arial, sans-serif; max-width: 60em; margin: 2em auto; color: #24292e; }
+nav a { margin-right: 1em; }
+table { border-collapse: collapse; width: 100%; }
+td, th { text-align: left; padding: 0.3em 0.5em; border-bottom: 1px solid #e1e4e8; }
+.label { border-radius: 1em; padding: 0 0.5em; font-size: 85%; background: #e1e4e8; }
+.progress { background: #e1e4e8; height: 0.5em; }
+.progress div { background: #28a745; height: 100%; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<nav><a href="index.html">Overview</a><a href="issues.html">Issues</a><a href="milestones.html">Milestones</a><a href="releases.html">Releases</a></nav>
+{{end}}
+
+{{define "footer"}}
+</body>
+</html>
+{{end}}
+`
+
+const indexTpl = `
+{{define "index"}}{{template "header" .}}
+<ul>
+<li><a href="issues.html">{{len .Issues}} issues</a></li>
+<li><a href="milestones.html">{{len .Milestones}} milestones</a></li>
+<li><a href="releases.html">{{len .Releases}} releases</a></li>
+</ul>
+{{if .Issues}}
+<h2>Issues closed per week</h2>
+{{.VelocityChart}}
+{{end}}
+{{template "footer" .}}{{end}}
+`
+
+const issuesTpl = `
+{{define "issues"}}{{template "header" .}}
+<table>
+<tr><th>#</th><th>Title</th><th>Labels</th><th>Milestone</th><th>Author</th><th>Updated</th></tr>
+{{range .Issues}}
+<tr>
+<td><a href="{{.HTMLURL}}">{{.Number}}</a></td>
+<td>{{.Type}}: {{.Title}}</td>
+<td>{{range .Labels}}{{.BadgeHTML}} {{end}}</td>
+<td>{{.Milestone.Title}}</td>
+<td>{{.User.Login}}</td>
+<td title="{{.Updated.Format "2006-01-02 15:04"}}">{{ago .Updated}}</td>
+</tr>
+{{end}}
+</table>
+{{template "footer" .}}{{end}}
+`
+
+const milestonesTpl = `
+{{define "milestones"}}{{template "header" .}}
+{{range .Milestones}}
+<h2><a href="{{.HTMLURL}}">{{.Title}}</a> ({{.State}})</h2>
+{{if .Due}}<p>Due {{.Due.Format "2006-01-02"}}</p>{{end}}
+<div class="progress"><div style="width: {{percent .ClosedIssues (add .OpenIssues .ClosedIssues)}}%"></div></div>
+<p>{{.ClosedIssues}} closed, {{.OpenIssues}} open</p>
+{{if $.Issues}}{{$.MilestoneBurndown .}}{{end}}
+{{.DescriptionHTML}}
+{{end}}
+{{template "footer" .}}{{end}}
+`
+
+const releasesTpl = `
+{{define "releases"}}{{template "header" .}}
+{{range .Releases}}
+<h2>{{if .Name}}{{.Name}}{{else}}{{.TagName}}{{end}}{{if .Prerelease}} (prerelease){{end}}</h2>
+<p>Published {{.Published.Format "2006-01-02"}} by {{.Author.Login}}</p>
+{{.BodyHTML}}
+{{if .Assets}}
+<table>
+<tr><th>Asset</th><th>Size</th><th>Downloads</th></tr>
+{{range .Assets}}
+<tr><td><a href="{{.BrowserDownloadURL}}">{{.Name}}</a></td><td>{{.Size}}</td><td>{{abbrev .DownloadCount}}</td></tr>
+{{end}}
+</table>
+{{end}}
+{{end}}
+{{template "footer" .}}{{end}}
+`
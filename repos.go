@@ -0,0 +1,266 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type Repository struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	FullName        string     `json:"full_name"`
+	Owner           User       `json:"owner"`
+	Description     string     `json:"description"`
+	URL             string     `json:"url"`
+	HTMLURL         string     `json:"html_url"`
+	Homepage        string     `json:"homepage"`
+	Language        string     `json:"language"`
+	Topics          []string   `json:"topics"`
+	Private         bool       `json:"private"`
+	Fork            bool       `json:"fork"`
+	Archived        bool       `json:"archived"`
+	DefaultBranch   string     `json:"default_branch"`
+	StargazersCount int        `json:"stargazers_count"`
+	ForksCount      int        `json:"forks_count"`
+	OpenIssuesCount int        `json:"open_issues_count"`
+	Pushed          *time.Time `json:"pushed_at"` // nil for empty repositories
+	Created         time.Time  `json:"created_at"`
+	Updated         time.Time  `json:"updated_at"`
+
+	Visibility string   `json:"visibility"` // "public", "private" or "internal"
+	License    *License `json:"license"`    // nil if none was detected
+
+	// Permissions are those of the authenticated user, or of the team
+	// when loaded with LoadTeamRepos. Nil when not known.
+	Permissions *RepositoryPermissions `json:"permissions"`
+	RoleName    string                 `json:"role_name"` // "admin", "maintain", "write", "triage", "read" or a custom role
+
+	// Settings, only present when loading a single repository.
+	HasIssues           bool `json:"has_issues"`
+	HasWiki             bool `json:"has_wiki"`
+	HasProjects         bool `json:"has_projects"`
+	AllowSquashMerge    bool `json:"allow_squash_merge"`
+	AllowMergeCommit    bool `json:"allow_merge_commit"`
+	AllowRebaseMerge    bool `json:"allow_rebase_merge"`
+	AllowAutoMerge      bool `json:"allow_auto_merge"`
+	DeleteBranchOnMerge bool `json:"delete_branch_on_merge"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+// License is the license detected in a repository.
+type License struct {
+	Key    string `json:"key"` // "mit", "mpl-2.0", ...
+	Name   string `json:"name"`
+	SPDXID string `json:"spdx_id"`
+	URL    string `json:"url"`
+}
+
+// RepositoryPermissions are the permissions granted on a repository.
+type RepositoryPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
+// RepositoryPatch is a change to repository settings. Nil fields are left
+// unchanged; use Bool and String to set them.
+type RepositoryPatch struct {
+	Name                *string `json:"name,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	Homepage            *string `json:"homepage,omitempty"`
+	Visibility          *string `json:"visibility,omitempty"` // "public", "private" or "internal"
+	DefaultBranch       *string `json:"default_branch,omitempty"`
+	HasIssues           *bool   `json:"has_issues,omitempty"`
+	HasWiki             *bool   `json:"has_wiki,omitempty"`
+	HasProjects         *bool   `json:"has_projects,omitempty"`
+	AllowSquashMerge    *bool   `json:"allow_squash_merge,omitempty"`
+	AllowMergeCommit    *bool   `json:"allow_merge_commit,omitempty"`
+	AllowRebaseMerge    *bool   `json:"allow_rebase_merge,omitempty"`
+	AllowAutoMerge      *bool   `json:"allow_auto_merge,omitempty"`
+	DeleteBranchOnMerge *bool   `json:"delete_branch_on_merge,omitempty"`
+	Archived            *bool   `json:"archived,omitempty"`
+}
+
+// Bool returns a pointer to v, for optional fields.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for optional fields.
+func Int(v int) *int {
+	return &v
+}
+
+// String returns a pointer to v, for optional fields.
+func String(v string) *string {
+	return &v
+}
+
+// StarredRepository is a repository along with the time it was starred.
+type StarredRepository struct {
+	Starred    time.Time  `json:"starred_at"`
+	Repository Repository `json:"repo"`
+}
+
+// LoadStarred loads the repositories starred by the user.
+func (c *Client) LoadStarred(username string) ([]Repository, error) {
+	link := c.apiURL("users", username, "starred")
+	repos, err := c.loadSlice(link, Repository{})
+	if err != nil {
+		return nil, err
+	}
+	return repos.([]Repository), nil
+}
+
+// LoadStarred is a wrapper around DefaultClient.LoadStarred.
+func LoadStarred(username string) ([]Repository, error) {
+	return DefaultClient.LoadStarred(username)
+}
+
+// LoadMyStarred loads the repositories starred by the authenticated user,
+// including when they were starred.
+func (c *Client) LoadMyStarred() ([]StarredRepository, error) {
+	link := c.apiURL("user/starred")
+	repos, err := c.loadSlice(link, StarredRepository{}, withAccept("application/vnd.github.star+json"))
+	if err != nil {
+		return nil, err
+	}
+	return repos.([]StarredRepository), nil
+}
+
+// LoadMyStarred is a wrapper around DefaultClient.LoadMyStarred.
+func LoadMyStarred() ([]StarredRepository, error) {
+	return DefaultClient.LoadMyStarred()
+}
+
+// LoadRepository loads the repository metadata.
+func (c *Client) LoadRepository(repo string) (Repository, error) {
+	link := c.apiURL("repos", repo)
+	var res Repository
+	if err := c.requestInto(link, &res); err != nil {
+		return Repository{}, err
+	}
+	return res, nil
+}
+
+// LoadRepository is a wrapper around DefaultClient.LoadRepository.
+func LoadRepository(repo string) (Repository, error) {
+	return DefaultClient.LoadRepository(repo)
+}
+
+// LoadOrgRepos loads the repositories of the organization. The query may
+// filter by "type" ("all", "public", "private", "forks", "sources",
+// "member") and set the "sort" ("created", "updated", "pushed",
+// "full_name") and "direction" ("asc", "desc").
+func (c *Client) LoadOrgRepos(org string, query url.Values) ([]Repository, error) {
+	return c.loadRepos(c.apiURL("orgs", org, "repos"), query)
+}
+
+// LoadOrgRepos is a wrapper around DefaultClient.LoadOrgRepos.
+func LoadOrgRepos(org string, query url.Values) ([]Repository, error) {
+	return DefaultClient.LoadOrgRepos(org, query)
+}
+
+// LoadUserRepos loads the public repositories of the user. The query may
+// filter by "type" ("all", "owner", "member") and set the "sort" and
+// "direction", as for LoadOrgRepos.
+func (c *Client) LoadUserRepos(username string, query url.Values) ([]Repository, error) {
+	return c.loadRepos(c.apiURL("users", username, "repos"), query)
+}
+
+// LoadUserRepos is a wrapper around DefaultClient.LoadUserRepos.
+func LoadUserRepos(username string, query url.Values) ([]Repository, error) {
+	return DefaultClient.LoadUserRepos(username, query)
+}
+
+// LoadRepositories loads the repositories of the user or organization,
+// with LoadUserRepos or LoadOrgRepos as appropriate.
+func (c *Client) LoadRepositories(owner string, query url.Values) ([]Repository, error) {
+	account, err := c.LoadUser(owner)
+	if err != nil {
+		return nil, err
+	}
+	if account.Type == "Organization" {
+		return c.LoadOrgRepos(owner, query)
+	}
+	return c.LoadUserRepos(owner, query)
+}
+
+// LoadRepositories is a wrapper around DefaultClient.LoadRepositories.
+func LoadRepositories(owner string, query url.Values) ([]Repository, error) {
+	return DefaultClient.LoadRepositories(owner, query)
+}
+
+// LoadForks loads the forks of the repository. The query may set the
+// "sort" to "newest", "oldest", "stargazers" or "watchers".
+func (c *Client) LoadForks(repo string, query url.Values) ([]Repository, error) {
+	return c.loadRepos(c.apiURL("repos", repo, "forks"), query)
+}
+
+// LoadForks is a wrapper around DefaultClient.LoadForks.
+func LoadForks(repo string, query url.Values) ([]Repository, error) {
+	return DefaultClient.LoadForks(repo, query)
+}
+
+// CreateFork forks the repository into the organization, or into the
+// authenticated user's account if org is empty. Forking happens
+// asynchronously; the returned repository may take a few moments to become
+// accessible.
+func (c *Client) CreateFork(repo, org string) (Repository, error) {
+	link := c.apiURL("repos", repo, "forks")
+	req := struct {
+		Organization string `json:"organization,omitempty"`
+	}{org}
+	var res Repository
+	if err := c.request("POST", link, req, &res); err != nil {
+		return Repository{}, err
+	}
+	return res, nil
+}
+
+// CreateFork is a wrapper around DefaultClient.CreateFork.
+func CreateFork(repo, org string) (Repository, error) {
+	return DefaultClient.CreateFork(repo, org)
+}
+
+func (c *Client) loadRepos(link string, query url.Values) ([]Repository, error) {
+	if query != nil {
+		link += "?" + query.Encode()
+	}
+	repos, err := c.loadSlice(link, Repository{})
+	if err != nil {
+		return nil, err
+	}
+	return repos.([]Repository), nil
+}
+
+// EditRepository changes the repository settings set in the patch,
+// returning the updated repository.
+func (c *Client) EditRepository(repo string, patch RepositoryPatch) (Repository, error) {
+	link := c.apiURL("repos", repo)
+	var res Repository
+	if err := c.request("PATCH", link, patch, &res); err != nil {
+		return Repository{}, err
+	}
+	return res, nil
+}
+
+// EditRepository is a wrapper around DefaultClient.EditRepository.
+func EditRepository(repo string, patch RepositoryPatch) (Repository, error) {
+	return DefaultClient.EditRepository(repo, patch)
+}
+
+// SetDefaultBranch changes the default branch of the repository to an
+// existing branch.
+func (c *Client) SetDefaultBranch(repo, branch string) (Repository, error) {
+	return c.EditRepository(repo, RepositoryPatch{DefaultBranch: String(branch)})
+}
+
+// SetDefaultBranch is a wrapper around DefaultClient.SetDefaultBranch.
+func SetDefaultBranch(repo, branch string) (Repository, error) {
+	return DefaultClient.SetDefaultBranch(repo, branch)
+}
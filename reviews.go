@@ -0,0 +1,243 @@
+package github
+
+import (
+	"encoding/json"
+	"html/template"
+	"strconv"
+	"time"
+)
+
+// Review is a review of a pull request.
+type Review struct {
+	ID        int       `json:"id"`
+	User      User      `json:"user"`
+	Body      string    `json:"body"`
+	State     string    `json:"state"` // "APPROVED", "CHANGES_REQUESTED", "COMMENTED", "DISMISSED" or "PENDING"
+	HTMLURL   string    `json:"html_url"`
+	CommitID  string    `json:"commit_id"`
+	Submitted time.Time `json:"submitted_at"`
+}
+
+// LoadReviews loads the reviews of the pull request, oldest first.
+func (c *Client) LoadReviews(repo string, number int) ([]Review, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "reviews")
+	reviews, err := c.loadSlice(link, Review{})
+	if err != nil {
+		return nil, err
+	}
+	return reviews.([]Review), nil
+}
+
+// LoadReviews is a wrapper around DefaultClient.LoadReviews.
+func LoadReviews(repo string, number int) ([]Review, error) {
+	return DefaultClient.LoadReviews(repo, number)
+}
+
+// ReviewRequest describes a review to create with CreateReview.
+type ReviewRequest struct {
+	CommitID string `json:"commit_id,omitempty"` // the head commit if empty
+	Body     string `json:"body,omitempty"`
+	// Event submits the review right away: "APPROVE", "REQUEST_CHANGES"
+	// or "COMMENT". The review is left pending if empty.
+	Event    string               `json:"event,omitempty"`
+	Comments []DraftReviewComment `json:"comments,omitempty"`
+}
+
+// DraftReviewComment is a comment on a line, or a range of lines, of the
+// diff, submitted as part of a review.
+type DraftReviewComment struct {
+	Path      string `json:"path"`
+	Body      string `json:"body"`
+	Line      int    `json:"line"`
+	Side      string `json:"side,omitempty"`       // "RIGHT" (default) for the new version, "LEFT" for the old
+	StartLine int    `json:"start_line,omitempty"` // for comments on several lines
+	StartSide string `json:"start_side,omitempty"`
+}
+
+// CreateReview creates a review of the pull request.
+func (c *Client) CreateReview(repo string, number int, review ReviewRequest) (Review, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "reviews")
+	var res Review
+	if err := c.request("POST", link, review, &res); err != nil {
+		return Review{}, err
+	}
+	return res, nil
+}
+
+// CreateReview is a wrapper around DefaultClient.CreateReview.
+func CreateReview(repo string, number int, review ReviewRequest) (Review, error) {
+	return DefaultClient.CreateReview(repo, number, review)
+}
+
+// SubmitReview submits a pending review with the given event ("APPROVE",
+// "REQUEST_CHANGES" or "COMMENT") and body.
+func (c *Client) SubmitReview(repo string, number, reviewID int, event, body string) (Review, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "reviews", strconv.Itoa(reviewID), "events")
+	req := struct {
+		Event string `json:"event"`
+		Body  string `json:"body,omitempty"`
+	}{event, body}
+	var res Review
+	if err := c.request("POST", link, req, &res); err != nil {
+		return Review{}, err
+	}
+	return res, nil
+}
+
+// SubmitReview is a wrapper around DefaultClient.SubmitReview.
+func SubmitReview(repo string, number, reviewID int, event, body string) (Review, error) {
+	return DefaultClient.SubmitReview(repo, number, reviewID, event, body)
+}
+
+// DismissReview dismisses a submitted review, giving the reason in the
+// message. Dismissing requires push access and applies to approving or
+// change requesting reviews.
+func (c *Client) DismissReview(repo string, number, reviewID int, message string) (Review, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "reviews", strconv.Itoa(reviewID), "dismissals")
+	var res Review
+	if err := c.request("PUT", link, map[string]string{"message": message}, &res); err != nil {
+		return Review{}, err
+	}
+	return res, nil
+}
+
+// DismissReview is a wrapper around DefaultClient.DismissReview.
+func DismissReview(repo string, number, reviewID int, message string) (Review, error) {
+	return DefaultClient.DismissReview(repo, number, reviewID, message)
+}
+
+// ReviewComment is a comment on the diff of a pull request.
+type ReviewComment struct {
+	ID        int       `json:"id"`
+	ReviewID  int       `json:"pull_request_review_id"`
+	InReplyTo int       `json:"in_reply_to_id"` // zero unless a reply
+	User      User      `json:"user"`
+	Body      string    `json:"body"`
+	Path      string    `json:"path"`
+	DiffHunk  string    `json:"diff_hunk"`
+	CommitID  string    `json:"commit_id"`
+	Line      int       `json:"line"` // zero if the line is no longer in the diff
+	Side      string    `json:"side"` // "LEFT" or "RIGHT"
+	StartLine int       `json:"start_line"`
+	StartSide string    `json:"start_side"`
+	HTMLURL   string    `json:"html_url"`
+	Reactions Reactions `json:"reactions"`
+	Created   time.Time `json:"created_at"`
+	Updated   time.Time `json:"updated_at"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+func (c ReviewComment) BodyHTML() template.HTML {
+	return renderMarkdown(c.Body)
+}
+
+// ReviewCommentRequest describes a single review comment to create with
+// CreateReviewComment. A reply needs only the body and InReplyTo.
+type ReviewCommentRequest struct {
+	Body      string `json:"body"`
+	CommitID  string `json:"commit_id,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Line      int    `json:"line,omitempty"`
+	Side      string `json:"side,omitempty"` // "RIGHT" (default) or "LEFT"
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+	InReplyTo int    `json:"in_reply_to,omitempty"` // a top level comment to reply to
+}
+
+// LoadReviewComments loads the review comments on the pull request, oldest
+// first.
+func (c *Client) LoadReviewComments(repo string, number int) ([]ReviewComment, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "comments")
+	comments, err := c.loadSlice(link, ReviewComment{})
+	if err != nil {
+		return nil, err
+	}
+	return comments.([]ReviewComment), nil
+}
+
+// LoadReviewComments is a wrapper around DefaultClient.LoadReviewComments.
+func LoadReviewComments(repo string, number int) ([]ReviewComment, error) {
+	return DefaultClient.LoadReviewComments(repo, number)
+}
+
+// CreateReviewComment comments on the diff of the pull request outside of
+// a review, or replies to an existing review comment.
+func (c *Client) CreateReviewComment(repo string, number int, comment ReviewCommentRequest) (ReviewComment, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "comments")
+	var res ReviewComment
+	if err := c.request("POST", link, comment, &res); err != nil {
+		return ReviewComment{}, err
+	}
+	return res, nil
+}
+
+// CreateReviewComment is a wrapper around DefaultClient.CreateReviewComment.
+func CreateReviewComment(repo string, number int, comment ReviewCommentRequest) (ReviewComment, error) {
+	return DefaultClient.CreateReviewComment(repo, number, comment)
+}
+
+// reviewersRequest names users by login and teams by slug. The API
+// requires the reviewers field, even when only teams are given.
+type reviewersRequest struct {
+	Reviewers     []string `json:"reviewers"`
+	TeamReviewers []string `json:"team_reviewers,omitempty"`
+}
+
+func newReviewersRequest(users, teams []string) reviewersRequest {
+	if users == nil {
+		users = []string{}
+	}
+	return reviewersRequest{users, teams}
+}
+
+// LoadRequestedReviewers loads the users and teams whose review of the
+// pull request has been requested and is still outstanding.
+func (c *Client) LoadRequestedReviewers(repo string, number int) ([]User, []Team, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "requested_reviewers")
+	var res struct {
+		Users []User `json:"users"`
+		Teams []Team `json:"teams"`
+	}
+	if err := c.requestInto(link, &res); err != nil {
+		return nil, nil, err
+	}
+	return res.Users, res.Teams, nil
+}
+
+// LoadRequestedReviewers is a wrapper around DefaultClient.LoadRequestedReviewers.
+func LoadRequestedReviewers(repo string, number int) ([]User, []Team, error) {
+	return DefaultClient.LoadRequestedReviewers(repo, number)
+}
+
+// RequestReviewers requests reviews of the pull request from the users,
+// given by login, and teams, given by slug.
+func (c *Client) RequestReviewers(repo string, number int, users, teams []string) (PullRequest, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "requested_reviewers")
+	var res PullRequest
+	if err := c.request("POST", link, newReviewersRequest(users, teams), &res); err != nil {
+		return PullRequest{}, err
+	}
+	return res, nil
+}
+
+// RequestReviewers is a wrapper around DefaultClient.RequestReviewers.
+func RequestReviewers(repo string, number int, users, teams []string) (PullRequest, error) {
+	return DefaultClient.RequestReviewers(repo, number, users, teams)
+}
+
+// RemoveRequestedReviewers withdraws review requests from the users and
+// teams.
+func (c *Client) RemoveRequestedReviewers(repo string, number int, users, teams []string) (PullRequest, error) {
+	link := c.apiURL("repos", repo, "pulls", strconv.Itoa(number), "requested_reviewers")
+	var res PullRequest
+	if err := c.request("DELETE", link, newReviewersRequest(users, teams), &res); err != nil {
+		return PullRequest{}, err
+	}
+	return res, nil
+}
+
+// RemoveRequestedReviewers is a wrapper around DefaultClient.RemoveRequestedReviewers.
+func RemoveRequestedReviewers(repo string, number int, users, teams []string) (PullRequest, error) {
+	return DefaultClient.RemoveRequestedReviewers(repo, number, users, teams)
+}
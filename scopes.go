@@ -0,0 +1,112 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+)
+
+// impliedScopes lists the OAuth scopes that are included in broader ones.
+var impliedScopes = map[string][]string{
+	"repo":             {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:org":        {"write:org", "read:org"},
+	"write:org":        {"read:org"},
+	"admin:public_key": {"write:public_key", "read:public_key"},
+	"write:public_key": {"read:public_key"},
+	"admin:repo_hook":  {"write:repo_hook", "read:repo_hook"},
+	"write:repo_hook":  {"read:repo_hook"},
+	"admin:gpg_key":    {"write:gpg_key", "read:gpg_key"},
+	"write:gpg_key":    {"read:gpg_key"},
+	"user":             {"read:user", "user:email", "user:follow"},
+	"write:packages":   {"read:packages"},
+	"write:discussion": {"read:discussion"},
+}
+
+// ScopeError is returned by CheckScopes when the token lacks required
+// scopes.
+type ScopeError struct {
+	Missing []string
+	Granted []string
+}
+
+func (e *ScopeError) Error() string {
+	return fmt.Sprintf("token is missing required scopes %s (has %s)", strings.Join(e.Missing, ", "), strings.Join(e.Granted, ", "))
+}
+
+// TokenScopes returns the OAuth scopes granted to the token in use, as
+// reported in the X-OAuth-Scopes header. The boolean is false when GitHub
+// does not report scopes for the credentials, as is the case for
+// fine-grained personal access tokens and app installation tokens.
+func (c *Client) TokenScopes() ([]string, bool, error) {
+	// The rate limit endpoint works for every kind of credentials, unlike
+	// /user which refuses installation tokens, and is free.
+	req, err := c.newRequest("GET", c.apiURL("rate_limit"), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, false, responseError(resp)
+	}
+
+	if _, ok := resp.Header["X-Oauth-Scopes"]; !ok {
+		return nil, false, nil
+	}
+	return parseScopes(resp.Header.Get("X-OAuth-Scopes")), true, nil
+}
+
+// TokenScopes is a wrapper around DefaultClient.TokenScopes.
+func TokenScopes() ([]string, bool, error) {
+	return DefaultClient.TokenScopes()
+}
+
+// CheckScopes returns a *ScopeError if the token in use lacks any of the
+// required OAuth scopes. Credentials that do not report scopes are
+// assumed to be sufficient.
+func (c *Client) CheckScopes(required ...string) error {
+	granted, ok, err := c.TokenScopes()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	have := make(map[string]bool)
+	for _, s := range granted {
+		have[s] = true
+		for _, implied := range impliedScopes[s] {
+			have[implied] = true
+		}
+	}
+	var missing []string
+	for _, s := range required {
+		if !have[s] {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) > 0 {
+		return &ScopeError{Missing: missing, Granted: granted}
+	}
+	return nil
+}
+
+// CheckScopes is a wrapper around DefaultClient.CheckScopes.
+func CheckScopes(required ...string) error {
+	return DefaultClient.CheckScopes(required...)
+}
+
+func parseScopes(hdr string) []string {
+	var scopes []string
+	for _, s := range strings.Split(hdr, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestTokenScopes(t *testing.T) {
+	cases := []struct {
+		name   string
+		header http.Header
+		scopes []string
+		ok     bool
+	}{
+		{"classic", http.Header{"X-Oauth-Scopes": {"repo, read:org"}}, []string{"repo", "read:org"}, true},
+		{"no scopes", http.Header{"X-Oauth-Scopes": {""}}, nil, true},
+		{"installation", http.Header{}, nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				// Installation tokens are refused by /user, so the
+				// scopes must be read from another endpoint.
+				if req.URL.Path == "/user" {
+					http.Error(w, `{"message":"Resource not accessible by integration"}`, http.StatusForbidden)
+					return
+				}
+				for k, v := range tc.header {
+					w.Header()[k] = v
+				}
+				w.Write([]byte(`{"resources":{}}`))
+			}))
+			defer srv.Close()
+
+			c := &Client{BaseURL: srv.URL, Tokens: StaticToken("token")}
+			scopes, ok, err := c.TokenScopes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tc.ok || !reflect.DeepEqual(scopes, tc.scopes) {
+				t.Errorf("got %q, %v; want %q, %v", scopes, ok, tc.scopes, tc.ok)
+			}
+		})
+	}
+}
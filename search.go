@@ -0,0 +1,163 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"time"
+)
+
+// SearchResult describes the outcome of a search, beyond the items found.
+type SearchResult struct {
+	// TotalCount is the number of matches. At most 1000 of them can be
+	// loaded.
+	TotalCount int
+	// IncompleteResults is set when the search timed out before all
+	// matches were found.
+	IncompleteResults bool
+}
+
+// CodeResult is a file matching a code search.
+type CodeResult struct {
+	Name       string     `json:"name"`
+	Path       string     `json:"path"`
+	SHA        string     `json:"sha"`
+	URL        string     `json:"url"`
+	HTMLURL    string     `json:"html_url"`
+	Repository Repository `json:"repository"`
+}
+
+// SearchIssues loads the issues and pull requests matching the search
+// query, such as "org:example label:bug state:open". The params may set
+// the "sort" and "order" of the results, and may be nil.
+func (c *Client) SearchIssues(q string, params url.Values) ([]Issue, SearchResult, error) {
+	items, res, err := c.search("issues", q, params, Issue{})
+	if err != nil {
+		return nil, res, err
+	}
+	return items.([]Issue), res, nil
+}
+
+// SearchIssues is a wrapper around DefaultClient.SearchIssues.
+func SearchIssues(q string, params url.Values) ([]Issue, SearchResult, error) {
+	return DefaultClient.SearchIssues(q, params)
+}
+
+// SearchRepositories loads the repositories matching the search query.
+func (c *Client) SearchRepositories(q string, params url.Values) ([]Repository, SearchResult, error) {
+	items, res, err := c.search("repositories", q, params, Repository{})
+	if err != nil {
+		return nil, res, err
+	}
+	return items.([]Repository), res, nil
+}
+
+// SearchRepositories is a wrapper around DefaultClient.SearchRepositories.
+func SearchRepositories(q string, params url.Values) ([]Repository, SearchResult, error) {
+	return DefaultClient.SearchRepositories(q, params)
+}
+
+// SearchCode loads the files matching the search query. Code search
+// requires authentication.
+func (c *Client) SearchCode(q string, params url.Values) ([]CodeResult, SearchResult, error) {
+	items, res, err := c.search("code", q, params, CodeResult{})
+	if err != nil {
+		return nil, res, err
+	}
+	return items.([]CodeResult), res, nil
+}
+
+// SearchCode is a wrapper around DefaultClient.SearchCode.
+func SearchCode(q string, params url.Values) ([]CodeResult, SearchResult, error) {
+	return DefaultClient.SearchCode(q, params)
+}
+
+// SearchUsers loads the users and organizations matching the search query.
+func (c *Client) SearchUsers(q string, params url.Values) ([]User, SearchResult, error) {
+	items, res, err := c.search("users", q, params, User{})
+	if err != nil {
+		return nil, res, err
+	}
+	return items.([]User), res, nil
+}
+
+// SearchUsers is a wrapper around DefaultClient.SearchUsers.
+func SearchUsers(q string, params url.Values) ([]User, SearchResult, error) {
+	return DefaultClient.SearchUsers(q, params)
+}
+
+// search loads all pages of a search, returning a slice of elemType. The
+// search API has its own, much lower, rate limit; when it has run out the
+// search pauses until it resets, if that is within MaxRateLimitWait.
+func (c *Client) search(kind, q string, params url.Values, elemType interface{}) (interface{}, SearchResult, error) {
+	t := reflect.TypeOf(elemType)
+	result := reflect.New(reflect.SliceOf(t)).Elem() // result is []elemType
+	var res SearchResult
+
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("q", q)
+	query.Set("per_page", "100")
+	link := c.apiURL("search", kind) + "?" + query.Encode()
+
+	for page := 0; link != ""; page++ {
+		if err := c.waitSearchRate(); err != nil {
+			return result.Interface(), res, err
+		}
+
+		req, err := c.newRequest("GET", link, nil)
+		if err != nil {
+			return result.Interface(), res, err
+		}
+		resp, err := c.Do(req)
+		if err != nil {
+			return result.Interface(), res, err
+		}
+		if resp.StatusCode > 299 {
+			err := responseError(resp)
+			resp.Body.Close()
+			return result.Interface(), res, err
+		}
+
+		var env struct {
+			TotalCount        int             `json:"total_count"`
+			IncompleteResults bool            `json:"incomplete_results"`
+			Items             json.RawMessage `json:"items"`
+		}
+		tmp := reflect.New(reflect.SliceOf(t)) // tmp is *[]elemType
+		err = json.NewDecoder(resp.Body).Decode(&env)
+		if err == nil && env.Items != nil {
+			err = c.unmarshalJSON(env.Items, tmp.Interface())
+		}
+		resp.Body.Close()
+		if err != nil {
+			return result.Interface(), res, err
+		}
+
+		if page == 0 {
+			res.TotalCount = env.TotalCount
+		}
+		res.IncompleteResults = res.IncompleteResults || env.IncompleteResults
+		result = reflect.AppendSlice(result, tmp.Elem())
+		link = parseRel(resp.Header.Get("Link"), "next")
+	}
+
+	return result.Interface(), res, nil
+}
+
+// waitSearchRate waits for the search rate limit to reset, if it has run
+// out and resets within MaxRateLimitWait. Otherwise the request is made
+// and fails as usual.
+func (c *Client) waitSearchRate() error {
+	rate, ok := c.Rate("search")
+	if !ok || rate.Remaining > 0 {
+		return nil
+	}
+	wait := time.Until(rate.Reset) + rateLimitSlack
+	if wait <= 0 || wait > c.MaxRateLimitWait {
+		return nil
+	}
+	return c.sleep(wait)
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version, as parsed from a release tag name.
+type Version struct {
+	Major, Minor, Patch int
+	Prerelease          string // "rc.1" for "v1.2.3-rc.1"
+	Build               string // build metadata, ignored for ordering
+}
+
+// ParseVersion parses a tag name like "v1.2.3", "1.2.3-rc.1" or
+// "v1.2.3+build.4" as a semantic version. The "v" prefix is optional, and
+// so are the minor and patch numbers ("v1.2" is 1.2.0).
+func ParseVersion(tag string) (Version, error) {
+	s := strings.TrimPrefix(tag, "v")
+	var v Version
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s, v.Build = s[:i], s[i+1:]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s, v.Prerelease = s[:i], s[i+1:]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", tag)
+	}
+	nums := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", tag)
+		}
+		*nums[i] = n
+	}
+	return v, nil
+}
+
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 as v is lower than, equal to or higher than
+// other, by semantic version precedence.
+func (v Version) Compare(other Version) int {
+	if c := compareInt(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+
+	// A prerelease is lower than the corresponding release.
+	switch {
+	case v.Prerelease == other.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case other.Prerelease == "":
+		return -1
+	}
+
+	a, b := strings.Split(v.Prerelease, "."), strings.Split(other.Prerelease, ".")
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, errA := strconv.Atoi(a[i])
+		nb, errB := strconv.Atoi(b[i])
+		switch {
+		case errA == nil && errB == nil:
+			if c := compareInt(na, nb); c != 0 {
+				return c
+			}
+		case errA == nil:
+			// Numeric identifiers are lower than alphanumeric ones.
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInt(len(a), len(b))
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Version returns the release tag name parsed as a semantic version. The
+// boolean is false if the tag is not a version.
+func (r Release) Version() (Version, bool) {
+	v, err := ParseVersion(r.TagName)
+	return v, err == nil
+}
+
+// SortReleases sorts releases by version, highest first. Releases whose
+// tags are not versions are sorted last, most recently published first.
+func SortReleases(rels []Release) {
+	sort.SliceStable(rels, func(a, b int) bool {
+		va, okA := rels[a].Version()
+		vb, okB := rels[b].Version()
+		switch {
+		case okA && okB:
+			return va.Compare(vb) > 0
+		case okA != okB:
+			return okA
+		default:
+			return rels[a].Published.After(rels[b].Published)
+		}
+	})
+}
+
+// LatestStable returns the published, non prerelease release with the
+// highest version. The boolean is false if there is none.
+func LatestStable(rels []Release) (Release, bool) {
+	return latestVersion(rels, func(r Release, v Version) bool {
+		return !r.Prerelease && v.Prerelease == ""
+	})
+}
+
+// LatestPrerelease returns the published prerelease with the highest
+// version. The boolean is false if there is none.
+func LatestPrerelease(rels []Release) (Release, bool) {
+	return latestVersion(rels, func(r Release, v Version) bool {
+		return r.Prerelease || v.Prerelease != ""
+	})
+}
+
+func latestVersion(rels []Release, match func(Release, Version) bool) (Release, bool) {
+	var best Release
+	var bestV Version
+	found := false
+	for _, r := range rels {
+		if r.Draft {
+			continue
+		}
+		v, ok := r.Version()
+		if !ok || !match(r, v) {
+			continue
+		}
+		if !found || v.Compare(bestV) > 0 {
+			best, bestV, found = r, v, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,76 @@
+package snapshot
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// JSONStore is a Store keeping samples in a file, as JSON Lines. Appends
+// are cheap; loads read the whole file.
+type JSONStore struct {
+	path string
+	mut  sync.Mutex
+}
+
+func NewJSONStore(path string) *JSONStore {
+	return &JSONStore{path: path}
+}
+
+func (s *JSONStore) Append(samples ...Sample) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	fd, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(fd)
+	enc := json.NewEncoder(bw)
+	for _, sample := range samples {
+		if err := enc.Encode(sample); err != nil {
+			fd.Close()
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		fd.Close()
+		return err
+	}
+	return fd.Close()
+}
+
+func (s *JSONStore) Load(key string, from, to time.Time) ([]Sample, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	fd, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	var res []Sample
+	dec := json.NewDecoder(bufio.NewReader(fd))
+	for dec.More() {
+		var sample Sample
+		if err := dec.Decode(&sample); err != nil {
+			return nil, err
+		}
+		if sample.Key != key || sample.Time.Before(from) || (!to.IsZero() && !sample.Time.Before(to)) {
+			continue
+		}
+		res = append(res, sample)
+	}
+
+	sort.SliceStable(res, func(a, b int) bool {
+		return res[a].Time.Before(res[b].Time)
+	})
+	return res, nil
+}
@@ -0,0 +1,109 @@
+// Package snapshot records periodic samples of counters that GitHub only
+// reports current totals for, such as stars and release download counts,
+// so that their growth over time can be reported.
+package snapshot
+
+import (
+	"sort"
+	"time"
+
+	"github.com/calmh/github"
+)
+
+// Sample is the value of a counter at a point in time. Keys are on the
+// form "owner/repo:stars", "owner/repo:forks", "owner/repo:downloads" (all
+// assets) and "owner/repo:downloads:tag/asset" (a single asset).
+type Sample struct {
+	Time  time.Time `json:"time"`
+	Key   string    `json:"key"`
+	Value int       `json:"value"`
+}
+
+// Store persists samples.
+type Store interface {
+	// Append adds samples to the store.
+	Append(samples ...Sample) error
+	// Load returns the samples for the key taken in [from, to), oldest
+	// first. A zero to means no upper bound.
+	Load(key string, from, to time.Time) ([]Sample, error)
+}
+
+// Collect loads the current star, fork and download counts for the
+// repository as samples, using the client.
+func Collect(c *github.Client, repo string) ([]Sample, error) {
+	r, err := c.LoadRepository(repo)
+	if err != nil {
+		return nil, err
+	}
+	rels, err := c.LoadReleases(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	samples := []Sample{
+		{Time: now, Key: repo + ":stars", Value: r.StargazersCount},
+		{Time: now, Key: repo + ":forks", Value: r.ForksCount},
+	}
+	total := 0
+	for _, rel := range rels {
+		for _, a := range rel.Assets {
+			samples = append(samples, Sample{Time: now, Key: repo + ":downloads:" + rel.TagName + "/" + a.Name, Value: a.DownloadCount})
+			total += a.DownloadCount
+		}
+	}
+	samples = append(samples, Sample{Time: now, Key: repo + ":downloads", Value: total})
+	return samples, nil
+}
+
+// Record collects samples for the repositories using the client and
+// appends them to the store.
+func Record(c *github.Client, store Store, repos ...string) error {
+	var all []Sample
+	for _, repo := range repos {
+		samples, err := Collect(c, repo)
+		if err != nil {
+			return err
+		}
+		all = append(all, samples...)
+	}
+	return store.Append(all...)
+}
+
+// Change is the difference in a counter between two consecutive samples.
+type Change struct {
+	From  time.Time
+	To    time.Time
+	Value int // value at To
+	Delta int // change since From
+}
+
+// Deltas returns the changes between consecutive samples.
+func Deltas(samples []Sample) []Change {
+	sorted := make([]Sample, len(samples))
+	copy(sorted, samples)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].Time.Before(sorted[b].Time)
+	})
+
+	var changes []Change
+	for i := 1; i < len(sorted); i++ {
+		changes = append(changes, Change{
+			From:  sorted[i-1].Time,
+			To:    sorted[i].Time,
+			Value: sorted[i].Value,
+			Delta: sorted[i].Value - sorted[i-1].Value,
+		})
+	}
+	return changes
+}
+
+// Growth returns how much the counter grew between the first and last
+// samples taken in [from, to).
+func Growth(store Store, key string, from, to time.Time) (int, error) {
+	samples, err := store.Load(key, from, to)
+	if err != nil || len(samples) < 2 {
+		return 0, err
+	}
+	return samples[len(samples)-1].Value - samples[0].Value, nil
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"time"
+)
+
+type Sponsorship struct {
+	Sponsor string // login of the sponsoring user or organization
+	Created time.Time
+	Private bool
+	OneTime bool
+	Tier    SponsorTier // zero value unless visible to the authenticated user
+}
+
+type SponsorTier struct {
+	Name                string
+	Description         string
+	MonthlyPriceInCents int
+	OneTime             bool
+	CustomAmount        bool
+}
+
+const sponsorshipsQuery = `query($login: String!, $cursor: String) {
+  repositoryOwner(login: $login) {
+    ... on Sponsorable {
+      sponsorshipsAsMaintainer(first: 100, after: $cursor, includePrivate: true) {
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+        nodes {
+          createdAt
+          isOneTimePayment
+          privacyLevel
+          sponsorEntity {
+            ... on User { login }
+            ... on Organization { login }
+          }
+          tier {
+            name
+            description
+            monthlyPriceInCents
+            isOneTime
+            isCustomAmount
+          }
+        }
+      }
+    }
+  }
+}`
+
+const sponsorTiersQuery = `query($login: String!) {
+  repositoryOwner(login: $login) {
+    ... on Sponsorable {
+      sponsorsListing {
+        tiers(first: 100) {
+          nodes {
+            name
+            description
+            monthlyPriceInCents
+            isOneTime
+            isCustomAmount
+          }
+        }
+      }
+    }
+  }
+}`
+
+type graphQLSponsorTier struct {
+	Name                string
+	Description         string
+	MonthlyPriceInCents int
+	IsOneTime           bool
+	IsCustomAmount      bool
+}
+
+func (t graphQLSponsorTier) tier() SponsorTier {
+	return SponsorTier{
+		Name:                t.Name,
+		Description:         t.Description,
+		MonthlyPriceInCents: t.MonthlyPriceInCents,
+		OneTime:             t.IsOneTime,
+		CustomAmount:        t.IsCustomAmount,
+	}
+}
+
+// LoadSponsorships loads the active sponsorships of the given user or
+// organization. Private sponsorships and tier amounts are only visible
+// when authenticated as the sponsored account.
+func (c *Client) LoadSponsorships(login string) ([]Sponsorship, error) {
+	var res struct {
+		RepositoryOwner struct {
+			SponsorshipsAsMaintainer struct {
+				PageInfo PageInfo
+				Nodes    []struct {
+					CreatedAt        time.Time
+					IsOneTimePayment bool
+					PrivacyLevel     string
+					SponsorEntity    struct {
+						Login string
+					}
+					Tier *graphQLSponsorTier
+				}
+			}
+		}
+	}
+	var result []Sponsorship
+	vars := map[string]interface{}{"login": login}
+	err := c.QueryPages(sponsorshipsQuery, vars, &res, func() (PageInfo, error) {
+		conn := res.RepositoryOwner.SponsorshipsAsMaintainer
+		for _, n := range conn.Nodes {
+			s := Sponsorship{
+				Sponsor: n.SponsorEntity.Login,
+				Created: n.CreatedAt,
+				Private: n.PrivacyLevel == "PRIVATE",
+				OneTime: n.IsOneTimePayment,
+			}
+			if n.Tier != nil {
+				s.Tier = n.Tier.tier()
+			}
+			result = append(result, s)
+		}
+		return conn.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// LoadSponsorships is a wrapper around DefaultClient.LoadSponsorships.
+func LoadSponsorships(login string) ([]Sponsorship, error) {
+	return DefaultClient.LoadSponsorships(login)
+}
+
+// LoadSponsorTiers loads the published sponsorship tiers of the given user
+// or organization.
+func (c *Client) LoadSponsorTiers(login string) ([]SponsorTier, error) {
+	var res struct {
+		RepositoryOwner struct {
+			SponsorsListing *struct {
+				Tiers struct {
+					Nodes []graphQLSponsorTier
+				}
+			}
+		}
+	}
+	vars := map[string]interface{}{"login": login}
+	if err := c.Query(sponsorTiersQuery, vars, &res); err != nil {
+		return nil, err
+	}
+
+	listing := res.RepositoryOwner.SponsorsListing
+	if listing == nil {
+		return nil, nil
+	}
+	tiers := make([]SponsorTier, len(listing.Tiers.Nodes))
+	for i, t := range listing.Tiers.Nodes {
+		tiers[i] = t.tier()
+	}
+	return tiers, nil
+}
+
+// LoadSponsorTiers is a wrapper around DefaultClient.LoadSponsorTiers.
+func LoadSponsorTiers(login string) ([]SponsorTier, error) {
+	return DefaultClient.LoadSponsorTiers(login)
+}
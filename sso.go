@@ -0,0 +1,54 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SSOError is returned when a request is refused because the organization
+// enforces SAML single sign-on and the token in use has not been
+// authorized for it.
+type SSOError struct {
+	// URL is where the user can authorize the token for the
+	// organization. It may be empty if GitHub did not provide one.
+	URL     string
+	Message string
+
+	apiErr *APIError
+}
+
+func (e *SSOError) Error() string {
+	if e.URL == "" {
+		return fmt.Sprintf("SAML SSO authorization required: %s", e.Message)
+	}
+	return fmt.Sprintf("SAML SSO authorization required, authorize the token at %s: %s", e.URL, e.Message)
+}
+
+// Unwrap returns the underlying *APIError.
+func (e *SSOError) Unwrap() error {
+	return e.apiErr
+}
+
+// ssoError returns an *SSOError if the response is an SSO enforcement
+// refusal, otherwise nil.
+func ssoError(resp *http.Response, apiErr *APIError) error {
+	if resp.StatusCode != http.StatusForbidden {
+		return nil
+	}
+	hdr := resp.Header.Get("X-GitHub-SSO")
+	if !strings.HasPrefix(hdr, "required") {
+		return nil
+	}
+	err := &SSOError{Message: apiErr.Message, apiErr: apiErr}
+	if err.Message == "" {
+		err.Message = strings.TrimSpace(string(apiErr.Body))
+	}
+	for _, part := range strings.Split(hdr, ";") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, "url=") {
+			err.URL = strings.TrimPrefix(part, "url=")
+		}
+	}
+	return err
+}
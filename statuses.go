@@ -0,0 +1,67 @@
+package github
+
+import (
+	"time"
+)
+
+// Status is a commit status reported by an external service.
+type Status struct {
+	ID          int64     `json:"id"`
+	State       string    `json:"state"` // "error", "failure", "pending" or "success"
+	Context     string    `json:"context"`
+	Description string    `json:"description"`
+	TargetURL   string    `json:"target_url"`
+	Creator     User      `json:"creator"`
+	Created     time.Time `json:"created_at"`
+	Updated     time.Time `json:"updated_at"`
+}
+
+// StatusRequest describes a status to set with CreateStatus.
+type StatusRequest struct {
+	State       string `json:"state"`             // "error", "failure", "pending" or "success"
+	Context     string `json:"context,omitempty"` // "default" if empty
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+}
+
+// CombinedStatus is the overall status of a commit, combining the latest
+// status for each context.
+type CombinedStatus struct {
+	State      string   `json:"state"` // "failure", "pending" or "success"
+	SHA        string   `json:"sha"`
+	TotalCount int      `json:"total_count"`
+	Statuses   []Status `json:"statuses"`
+}
+
+// CreateStatus sets a status on the commit. A later status with the same
+// context replaces it in the combined status.
+func (c *Client) CreateStatus(repo, sha string, status StatusRequest) (Status, error) {
+	link := c.apiURL("repos", repo, "statuses", sha)
+	var res Status
+	if err := c.request("POST", link, status, &res); err != nil {
+		return Status{}, err
+	}
+	return res, nil
+}
+
+// CreateStatus is a wrapper around DefaultClient.CreateStatus.
+func CreateStatus(repo, sha string, status StatusRequest) (Status, error) {
+	return DefaultClient.CreateStatus(repo, sha, status)
+}
+
+// GetCombinedStatus loads the combined status of the ref, which may be a
+// commit SHA, branch or tag name. At most 100 contexts are included.
+// Check runs are not part of the combined status; see LoadCheckRuns.
+func (c *Client) GetCombinedStatus(repo, ref string) (CombinedStatus, error) {
+	link := c.apiURL("repos", repo, "commits", escapePath(ref), "status") + "?per_page=100"
+	var res CombinedStatus
+	if err := c.requestInto(link, &res); err != nil {
+		return CombinedStatus{}, err
+	}
+	return res, nil
+}
+
+// GetCombinedStatus is a wrapper around DefaultClient.GetCombinedStatus.
+func GetCombinedStatus(repo, ref string) (CombinedStatus, error) {
+	return DefaultClient.GetCombinedStatus(repo, ref)
+}
@@ -0,0 +1,83 @@
+package github
+
+// LoadTeamBySlug loads the organization's team with the given slug, the
+// URL friendly form of the team name.
+func (c *Client) LoadTeamBySlug(org, slug string) (Team, error) {
+	link := c.apiURL("orgs", org, "teams", slug)
+	var team Team
+	if err := c.requestInto(link, &team); err != nil {
+		return Team{}, err
+	}
+	return team, nil
+}
+
+// LoadTeamBySlug is a wrapper around DefaultClient.LoadTeamBySlug.
+func LoadTeamBySlug(org, slug string) (Team, error) {
+	return DefaultClient.LoadTeamBySlug(org, slug)
+}
+
+// LoadTeamMembersBySlug loads the members of the team, including members
+// of its child teams.
+func (c *Client) LoadTeamMembersBySlug(org, slug string) ([]User, error) {
+	link := c.apiURL("orgs", org, "teams", slug, "members")
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadTeamMembersBySlug is a wrapper around DefaultClient.LoadTeamMembersBySlug.
+func LoadTeamMembersBySlug(org, slug string) ([]User, error) {
+	return DefaultClient.LoadTeamMembersBySlug(org, slug)
+}
+
+// LoadChildTeams loads the teams directly below the team.
+func (c *Client) LoadChildTeams(org, slug string) ([]Team, error) {
+	link := c.apiURL("orgs", org, "teams", slug, "teams")
+	teams, err := c.loadSlice(link, Team{})
+	if err != nil {
+		return nil, err
+	}
+	return teams.([]Team), nil
+}
+
+// LoadChildTeams is a wrapper around DefaultClient.LoadChildTeams.
+func LoadChildTeams(org, slug string) ([]Team, error) {
+	return DefaultClient.LoadChildTeams(org, slug)
+}
+
+// LoadTeamRepos loads the repositories the team has access to, with the
+// team's permissions on each.
+func (c *Client) LoadTeamRepos(org, slug string) ([]Repository, error) {
+	return c.loadRepos(c.apiURL("orgs", org, "teams", slug, "repos"), nil)
+}
+
+// LoadTeamRepos is a wrapper around DefaultClient.LoadTeamRepos.
+func LoadTeamRepos(org, slug string) ([]Repository, error) {
+	return DefaultClient.LoadTeamRepos(org, slug)
+}
+
+// SetTeamRepoPermission grants the team access to the repository, or
+// changes its access, with the given permission ("pull", "triage",
+// "push", "maintain", "admin" or a custom role name).
+func (c *Client) SetTeamRepoPermission(org, slug, repo, permission string) error {
+	link := c.apiURL("orgs", org, "teams", slug, "repos", repo)
+	return c.request("PUT", link, map[string]string{"permission": permission}, nil)
+}
+
+// SetTeamRepoPermission is a wrapper around DefaultClient.SetTeamRepoPermission.
+func SetTeamRepoPermission(org, slug, repo, permission string) error {
+	return DefaultClient.SetTeamRepoPermission(org, slug, repo, permission)
+}
+
+// RemoveTeamRepo revokes the team's access to the repository.
+func (c *Client) RemoveTeamRepo(org, slug, repo string) error {
+	link := c.apiURL("orgs", org, "teams", slug, "repos", repo)
+	return c.request("DELETE", link, nil, nil)
+}
+
+// RemoveTeamRepo is a wrapper around DefaultClient.RemoveTeamRepo.
+func RemoveTeamRepo(org, slug, repo string) error {
+	return DefaultClient.RemoveTeamRepo(org, slug, repo)
+}
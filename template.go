@@ -0,0 +1,114 @@
+package github
+
+import (
+	"fmt"
+	"html/template"
+	"time"
+)
+
+// FuncMap returns template functions for presenting the package types:
+//
+//	ago        relative time, "3 days ago"
+//	openFor    how long something has been (or was) open, "2 weeks"
+//	duration   a time.Duration in the same humanized form
+//	abbrev     abbreviated number, "1.2k"
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"ago":      ago,
+		"openFor":  openFor,
+		"duration": humanDuration,
+		"abbrev":   abbrev,
+	}
+}
+
+// ago returns a humanized description of how long ago t was. It accepts a
+// time.Time or *time.Time; a nil pointer gives an empty string.
+func ago(t interface{}) string {
+	tm, ok := timeValue(t)
+	if !ok {
+		return ""
+	}
+	d := time.Since(tm)
+	if d < 0 {
+		return "in " + humanDuration(-d)
+	}
+	if d < time.Minute {
+		return "just now"
+	}
+	return humanDuration(d) + " ago"
+}
+
+// openFor returns the humanized time between created and closed, or
+// between created and now if closed is nil.
+func openFor(created time.Time, closed *time.Time) string {
+	end := time.Now()
+	if closed != nil {
+		end = *closed
+	}
+	return humanDuration(end.Sub(created))
+}
+
+func humanDuration(d time.Duration) string {
+	const (
+		day   = 24 * time.Hour
+		week  = 7 * day
+		month = 30 * day
+		year  = 365 * day
+	)
+	units := []struct {
+		size time.Duration
+		name string
+	}{
+		{year, "year"},
+		{month, "month"},
+		{week, "week"},
+		{day, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+	}
+	for _, u := range units {
+		if d >= u.size {
+			return plural(int(d/u.size), u.name)
+		}
+	}
+	return plural(int(d/time.Second), "second")
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// abbrev formats n as 950, 1.2k, 34k, 5.6M and so on.
+func abbrev(n int) string {
+	switch {
+	case n < 0:
+		return "-" + abbrev(-n)
+	case n < 1000:
+		return fmt.Sprint(n)
+	case n < 10000:
+		return fmt.Sprintf("%.1fk", float64(n)/1e3)
+	case n < 1000000:
+		return fmt.Sprintf("%dk", n/1000)
+	case n < 10000000:
+		return fmt.Sprintf("%.1fM", float64(n)/1e6)
+	default:
+		return fmt.Sprintf("%dM", n/1000000)
+	}
+}
+
+func timeValue(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, false
+		}
+		return *t, true
+	default:
+		return time.Time{}, false
+	}
+}
@@ -0,0 +1,115 @@
+package github
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// TimelineEvent is an entry in the timeline of an issue or pull request.
+// Which of the fields are set depends on the kind of event.
+type TimelineEvent struct {
+	ID      int64     `json:"id"`
+	Event   string    `json:"event"` // "commented", "cross-referenced", "labeled", "milestoned", "closed", "committed", ...
+	Actor   User      `json:"actor"`
+	Created time.Time `json:"created_at"` // zero for "committed"
+
+	// "commented"
+	User    User   `json:"user"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+
+	// "labeled" and "unlabeled"
+	Label *Label `json:"label"`
+
+	// "milestoned" and "demilestoned", with only the title set
+	Milestone *Milestone `json:"milestone"`
+
+	// "assigned" and "unassigned"
+	Assignee *User `json:"assignee"`
+
+	// "closed" and "referenced", when caused by a commit
+	CommitID string `json:"commit_id"`
+
+	// "cross-referenced": the issue or pull request referring to this one
+	Source *struct {
+		Type  string `json:"type"` // "issue"
+		Issue Issue  `json:"issue"`
+	} `json:"source"`
+
+	// "committed", for pull requests
+	SHA       string        `json:"sha"`
+	Message   string        `json:"message"`
+	Author    *CommitAuthor `json:"author"`
+	Committer *CommitAuthor `json:"committer"`
+
+	Raw json.RawMessage `json:"-"` // original JSON, if RetainRawJSON is set
+}
+
+// LoadIssueTimeline loads the timeline of an issue or pull request, oldest
+// first.
+func (c *Client) LoadIssueTimeline(repo string, number int) ([]TimelineEvent, error) {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "timeline") + "?per_page=100"
+	events, err := c.loadSlice(link, TimelineEvent{})
+	if err != nil {
+		return nil, err
+	}
+	return events.([]TimelineEvent), nil
+}
+
+// LoadIssueTimeline is a wrapper around DefaultClient.LoadIssueTimeline.
+func LoadIssueTimeline(repo string, number int) ([]TimelineEvent, error) {
+	return DefaultClient.LoadIssueTimeline(repo, number)
+}
+
+// IssueEvent is a change to an issue or pull request, such as labeling,
+// assignment or closing.
+type IssueEvent struct {
+	ID        int64      `json:"id"`
+	Event     string     `json:"event"` // "labeled", "unlabeled", "assigned", "closed", "renamed", ...
+	Actor     User       `json:"actor"`
+	Label     *Label     `json:"label"`     // name and color only
+	Assignee  *User      `json:"assignee"`  // for "assigned" and "unassigned"
+	Milestone *Milestone `json:"milestone"` // title only
+	CommitID  string     `json:"commit_id"` // for events caused by a commit
+	Rename    *struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"rename"` // for "renamed"
+	Created time.Time `json:"created_at"`
+
+	// Issue is set when loading the events of a whole repository.
+	Issue *Issue `json:"issue"`
+}
+
+// LoadIssueEvents loads the events of an issue or pull request, oldest
+// first.
+func (c *Client) LoadIssueEvents(repo string, number int) ([]IssueEvent, error) {
+	link := c.apiURL("repos", repo, "issues", strconv.Itoa(number), "events") + "?per_page=100"
+	events, err := c.loadSlice(link, IssueEvent{})
+	if err != nil {
+		return nil, err
+	}
+	return events.([]IssueEvent), nil
+}
+
+// LoadIssueEvents is a wrapper around DefaultClient.LoadIssueEvents.
+func LoadIssueEvents(repo string, number int) ([]IssueEvent, error) {
+	return DefaultClient.LoadIssueEvents(repo, number)
+}
+
+// LoadRepoIssueEvents loads the issue and pull request events in the
+// repository, newest first.
+func (c *Client) LoadRepoIssueEvents(repo string) ([]IssueEvent, error) {
+	link := c.apiURL("repos", repo, "issues/events") + "?per_page=100"
+	events, err := c.loadSlice(link, IssueEvent{})
+	if err != nil {
+		return nil, err
+	}
+	return events.([]IssueEvent), nil
+}
+
+// LoadRepoIssueEvents is a wrapper around DefaultClient.LoadRepoIssueEvents.
+func LoadRepoIssueEvents(repo string) ([]IssueEvent, error) {
+	return DefaultClient.LoadRepoIssueEvents(repo)
+}
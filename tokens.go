@@ -0,0 +1,71 @@
+package github
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenSource supplies API tokens, for credentials that change over time.
+// The source is consulted for every request, including each page of a
+// paginated load.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// tokenRenewMargin is how long before expiry an installation token is
+// replaced.
+const tokenRenewMargin = 5 * time.Minute
+
+// InstallationTokenSource returns a TokenSource that issues installation
+// tokens for the installation, transparently replacing them shortly
+// before they expire. The source is shared by all callers for the same
+// installation, so that tokens are reused. It is safe for concurrent use.
+func (a *App) InstallationTokenSource(installationID int64) TokenSource {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+	src, ok := a.tokenSources[installationID]
+	if !ok {
+		if a.tokenSources == nil {
+			a.tokenSources = make(map[int64]TokenSource)
+		}
+		src = &installationTokenSource{
+			app:            a,
+			installationID: installationID,
+		}
+		a.tokenSources[installationID] = src
+	}
+	return src
+}
+
+type installationTokenSource struct {
+	app            *App
+	installationID int64
+
+	mut sync.Mutex
+	tok InstallationToken
+}
+
+func (s *installationTokenSource) Token() (string, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if time.Until(s.tok.Expires) > tokenRenewMargin {
+		return s.tok.Token, nil
+	}
+	tok, err := s.app.CreateInstallationToken(s.installationID)
+	if err != nil {
+		return "", err
+	}
+	s.tok = tok
+	return tok.Token, nil
+}
+
+// InstallationClient returns a client authenticated as the installation,
+// with tokens from InstallationTokenSource. It shares the HTTP client and
+// base URL of the app's client.
+func (a *App) InstallationClient(installationID int64) *Client {
+	c := *a.client()
+	c.Tokens = a.InstallationTokenSource(installationID)
+	c.Username = ""
+	return &c
+}
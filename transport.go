@@ -0,0 +1,216 @@
+package github
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUserAgent identifies requests from this package, unless
+// Transport.UserAgent is set.
+const DefaultUserAgent = "calmh-github (+https://github.com/calmh/github)"
+
+// DefaultTransport is the Transport used by clients that do not set their
+// own HTTPClient.
+var DefaultTransport = &Transport{}
+
+// Transport is an http.RoundTripper implementing the request policy of
+// this package: it makes GET requests to the GitHub API conditional on
+// previously seen ETags and answers them from its cache when GitHub
+// responds 304 Not Modified (which does not count against the rate
+// limit), and keeps track of the current rate limits. Authentication is
+// done by the Client, before the request reaches the transport.
+//
+// Use it as the transport of a Client's HTTPClient to give it a cache and
+// rate limit tracking separate from DefaultTransport.
+type Transport struct {
+	// Base is the underlying RoundTripper; http.DefaultTransport is used
+	// if it is nil.
+	Base http.RoundTripper
+
+	// UserAgent is sent with requests that do not already set one. GitHub
+	// asks integrations to identify themselves, preferably with the
+	// application name and a contact. DefaultUserAgent is used if empty.
+	UserAgent string
+
+	// Cache stores responses for conditional requests. A MemoryCache of
+	// DefaultMemoryCacheSize is used if nil.
+	Cache Cache
+
+	mut          sync.Mutex
+	interceptors []Interceptor
+	memCache     *MemoryCache
+	rates        map[string]Rate
+}
+
+// Rate is the rate limit status for a class of requests.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// An Interceptor wraps the sending of an API request. It may modify the
+// request before passing it on to next, and observe or replace the
+// response. Interceptors see requests as they go out on the wire, after
+// authentication and with any conditional cache headers set.
+type Interceptor func(req *http.Request, next RoundTripFunc) (*http.Response, error)
+
+// RoundTripFunc is an http.RoundTripper implemented by a function.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", t.userAgent())
+	}
+
+	if !isAPIRequest(req.URL) {
+		return t.base().RoundTrip(req)
+	}
+
+	var key string
+	var cached *http.Response
+	if cacheable(req) {
+		key = cacheKey(req)
+		if data, ok := t.cache().Get(key); ok {
+			// Undecodable data is treated as missing.
+			if cached, _ = decodeResponse(data, req); cached != nil {
+				req.Header.Set("If-None-Match", cached.Header.Get("ETag"))
+			}
+		}
+	}
+
+	resp, err := t.send(req)
+	if err != nil {
+		return nil, err
+	}
+	t.updateRate(resp)
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return cached, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if key != "" && etag != "" && resp.StatusCode == http.StatusOK && strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		data, err := encodeResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		t.cache().Set(key, data)
+	}
+
+	return resp, nil
+}
+
+// Use registers interceptors for all API requests made through the
+// transport, including GraphQL queries. The first registered interceptor
+// is the outermost one.
+func (t *Transport) Use(interceptors ...Interceptor) {
+	t.mut.Lock()
+	t.interceptors = append(t.interceptors, interceptors...)
+	t.mut.Unlock()
+}
+
+// send passes the request through the interceptors to the base
+// RoundTripper.
+func (t *Transport) send(req *http.Request) (*http.Response, error) {
+	t.mut.Lock()
+	interceptors := t.interceptors
+	t.mut.Unlock()
+
+	next := RoundTripFunc(t.base().RoundTrip)
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		icpt, inner := interceptors[i], next
+		next = func(req *http.Request) (*http.Response, error) {
+			return icpt(req, inner)
+		}
+	}
+	return next(req)
+}
+
+// Rate returns the most recently seen rate limit status for the given
+// resource ("core", "search", "graphql", ...). The boolean is false if no
+// response for the resource has been seen yet.
+func (t *Transport) Rate(resource string) (Rate, bool) {
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	r, ok := t.rates[resource]
+	return r, ok
+}
+
+func (t *Transport) updateRate(resp *http.Response) {
+	r, resource, ok := parseRate(resp.Header)
+	if !ok {
+		return
+	}
+
+	t.mut.Lock()
+	if t.rates == nil {
+		t.rates = make(map[string]Rate)
+	}
+	t.rates[resource] = r
+	t.mut.Unlock()
+}
+
+// parseRate returns the rate limit status in the response headers and the
+// resource it applies to. The boolean is false if there is none.
+func parseRate(h http.Header) (Rate, string, bool) {
+	remaining := h.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return Rate{}, "", false
+	}
+	var r Rate
+	r.Remaining, _ = strconv.Atoi(remaining)
+	r.Limit, _ = strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		r.Reset = time.Unix(reset, 0)
+	}
+	resource := h.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+	return r, resource, true
+}
+
+func (t *Transport) userAgent() string {
+	if t.UserAgent != "" {
+		return t.UserAgent
+	}
+	return DefaultUserAgent
+}
+
+func (t *Transport) cache() Cache {
+	if t.Cache != nil {
+		return t.Cache
+	}
+	t.mut.Lock()
+	defer t.mut.Unlock()
+	if t.memCache == nil {
+		t.memCache = new(MemoryCache)
+	}
+	return t.memCache
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// isAPIRequest returns true for requests to the API, either on
+// api.github.com or on a GitHub Enterprise Server, where all API paths
+// are under /api/.
+func isAPIRequest(u *url.URL) bool {
+	return apiHosts[u.Host] || strings.HasPrefix(u.Path, "/api/")
+}
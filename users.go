@@ -0,0 +1,232 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// LoadUser loads the profile of the user or organization.
+func (c *Client) LoadUser(username string) (User, error) {
+	link := c.apiURL("users", username)
+	var user User
+	if err := c.requestInto(link, &user); err != nil {
+		return User{}, err
+	}
+	return user, nil
+}
+
+// LoadUser is a wrapper around DefaultClient.LoadUser.
+func LoadUser(username string) (User, error) {
+	return DefaultClient.LoadUser(username)
+}
+
+// LoadFollowers loads the users following the given user.
+func (c *Client) LoadFollowers(username string) ([]User, error) {
+	link := c.apiURL("users", username, "followers")
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadFollowers is a wrapper around DefaultClient.LoadFollowers.
+func LoadFollowers(username string) ([]User, error) {
+	return DefaultClient.LoadFollowers(username)
+}
+
+// LoadFollowing loads the users the given user is following.
+func (c *Client) LoadFollowing(username string) ([]User, error) {
+	link := c.apiURL("users", username, "following")
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadFollowing is a wrapper around DefaultClient.LoadFollowing.
+func LoadFollowing(username string) ([]User, error) {
+	return DefaultClient.LoadFollowing(username)
+}
+
+// IsFollowing returns true if the user follows the target user.
+func (c *Client) IsFollowing(username, target string) (bool, error) {
+	link := c.apiURL("users", username, "following", target)
+	err := c.request("GET", link, nil, nil)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// IsFollowing is a wrapper around DefaultClient.IsFollowing.
+func IsFollowing(username, target string) (bool, error) {
+	return DefaultClient.IsFollowing(username, target)
+}
+
+// usersBatchSize is the number of users resolved per GraphQL query.
+const usersBatchSize = 50
+
+// LoadUsersBatch loads the given users with a single GraphQL query per
+// batch of users, rather than one request per user. The result is keyed
+// by login; users that do not exist are left out. Users are cached in the
+// client's UserCache, if set.
+func (c *Client) LoadUsersBatch(logins []string) (map[string]User, error) {
+	scope, err := c.userCacheScope()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]User)
+	var missing []string
+	for _, login := range logins {
+		if u, ok := c.cachedUser(scope, login); ok {
+			res[login] = u
+		} else {
+			missing = append(missing, login)
+		}
+	}
+
+	for len(missing) > 0 {
+		batch := missing
+		if len(batch) > usersBatchSize {
+			batch = batch[:usersBatchSize]
+		}
+		missing = missing[len(batch):]
+
+		var params, fields []string
+		vars := make(map[string]interface{})
+		for i, login := range batch {
+			params = append(params, fmt.Sprintf("$l%d: String!", i))
+			fields = append(fields, fmt.Sprintf("u%d: user(login: $l%d) { login databaseId email }", i, i))
+			vars[fmt.Sprintf("l%d", i)] = login
+		}
+		query := "query(" + strings.Join(params, ", ") + ") {\n" + strings.Join(fields, "\n") + "\n}"
+
+		var data map[string]*struct {
+			Login      string
+			DatabaseID int
+			Email      string
+		}
+		if err := c.Query(query, vars, &data); err != nil {
+			// Logins that don't resolve to a user are reported as
+			// NOT_FOUND errors alongside the data for the others.
+			var errs GraphQLErrors
+			if !errors.As(err, &errs) || !errors.Is(errs, ErrNotFound) {
+				return nil, err
+			}
+		}
+
+		for i, login := range batch {
+			u := data[fmt.Sprintf("u%d", i)]
+			if u == nil {
+				continue
+			}
+			user := User{Login: u.Login, ID: u.DatabaseID, Email: u.Email}
+			c.cacheUser(scope, login, user)
+			res[login] = user
+		}
+	}
+
+	return res, nil
+}
+
+// userCacheScope identifies the API and credentials of the client, so
+// that users cached for one server or token are never returned for
+// another; the email addresses visible depend on both.
+func (c *Client) userCacheScope() (string, error) {
+	if c.UserCache == nil {
+		return "", nil
+	}
+	tok := ""
+	if c.Tokens != nil {
+		var err error
+		if tok, err = c.Tokens.Token(); err != nil {
+			return "", err
+		}
+	}
+	h := sha256.Sum256([]byte(c.Username + ":" + tok))
+	return "users " + c.baseURL() + " " + hex.EncodeToString(h[:]) + " ", nil
+}
+
+func (c *Client) cachedUser(scope, login string) (User, bool) {
+	if c.UserCache == nil {
+		return User{}, false
+	}
+	data, ok := c.UserCache.Get(scope + strings.ToLower(login))
+	if !ok {
+		return User{}, false
+	}
+	var u User
+	if err := json.Unmarshal(data, &u); err != nil {
+		return User{}, false
+	}
+	return u, true
+}
+
+func (c *Client) cacheUser(scope, login string, u User) {
+	if c.UserCache == nil {
+		return
+	}
+	if data, err := json.Marshal(u); err == nil {
+		c.UserCache.Set(scope+strings.ToLower(login), data)
+	}
+}
+
+// LoadUsersBatch is a wrapper around DefaultClient.LoadUsersBatch.
+func LoadUsersBatch(logins []string) (map[string]User, error) {
+	return DefaultClient.LoadUsersBatch(logins)
+}
+
+type pushEvent struct {
+	Type    string
+	Payload struct {
+		Commits []struct {
+			Author struct {
+				Name  string
+				Email string
+			}
+		}
+	}
+}
+
+// commitEmail returns the most common author email of the user's own
+// commits in their recent public push events, ignoring GitHub's noreply
+// addresses, or an empty string if there is none. Pushes may contain
+// other people's commits, so only commits with the user's login or
+// profile name as author name are counted.
+func (c *Client) commitEmail(user User) (string, error) {
+	link := c.apiURL("users", user.Login, "events/public")
+	events, err := c.loadSlice(link, pushEvent{})
+	if err != nil {
+		return "", err
+	}
+
+	counts := make(map[string]int)
+	best := ""
+	for _, ev := range events.([]pushEvent) {
+		if ev.Type != "PushEvent" {
+			continue
+		}
+		for _, commit := range ev.Payload.Commits {
+			name := commit.Author.Name
+			if !strings.EqualFold(name, user.Login) && (user.Name == "" || !strings.EqualFold(name, user.Name)) {
+				continue
+			}
+			email := strings.ToLower(commit.Author.Email)
+			if email == "" || strings.HasSuffix(email, "noreply.github.com") {
+				continue
+			}
+			counts[email]++
+			if counts[email] > counts[best] {
+				best = email
+			}
+		}
+	}
+	return best, nil
+}
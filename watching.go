@@ -0,0 +1,85 @@
+package github
+
+import (
+	"errors"
+	"time"
+)
+
+// Subscription is the authenticated user's notification subscription to
+// a repository or a notification thread.
+type Subscription struct {
+	Subscribed    bool      `json:"subscribed"` // notified of all activity
+	Ignored       bool      `json:"ignored"`    // never notified
+	Reason        string    `json:"reason"`
+	URL           string    `json:"url"`
+	RepositoryURL string    `json:"repository_url"` // repository subscriptions
+	ThreadURL     string    `json:"thread_url"`     // thread subscriptions
+	Created       time.Time `json:"created_at"`
+}
+
+// LoadWatchers loads the users watching the repository.
+func (c *Client) LoadWatchers(repo string) ([]User, error) {
+	link := c.apiURL("repos", repo, "subscribers")
+	users, err := c.loadSlice(link, User{})
+	if err != nil {
+		return nil, err
+	}
+	return users.([]User), nil
+}
+
+// LoadWatchers is a wrapper around DefaultClient.LoadWatchers.
+func LoadWatchers(repo string) ([]User, error) {
+	return DefaultClient.LoadWatchers(repo)
+}
+
+// GetSubscription loads the authenticated user's subscription to the
+// repository. Without a subscription, which means the default of being
+// notified when participating or mentioned, the zero Subscription is
+// returned.
+func (c *Client) GetSubscription(repo string) (Subscription, error) {
+	link := c.apiURL("repos", repo, "subscription")
+	var sub Subscription
+	err := c.requestInto(link, &sub)
+	if errors.Is(err, ErrNotFound) {
+		return Subscription{}, nil
+	}
+	return sub, err
+}
+
+// GetSubscription is a wrapper around DefaultClient.GetSubscription.
+func GetSubscription(repo string) (Subscription, error) {
+	return DefaultClient.GetSubscription(repo)
+}
+
+// SetSubscription subscribes the authenticated user to all activity in
+// the repository, or with ignored set mutes all notifications from it.
+func (c *Client) SetSubscription(repo string, subscribed, ignored bool) (Subscription, error) {
+	link := c.apiURL("repos", repo, "subscription")
+	req := struct {
+		Subscribed bool `json:"subscribed"`
+		Ignored    bool `json:"ignored"`
+	}{subscribed, ignored}
+	var sub Subscription
+	if err := c.request("PUT", link, req, &sub); err != nil {
+		return Subscription{}, err
+	}
+	return sub, nil
+}
+
+// SetSubscription is a wrapper around DefaultClient.SetSubscription.
+func SetSubscription(repo string, subscribed, ignored bool) (Subscription, error) {
+	return DefaultClient.SetSubscription(repo, subscribed, ignored)
+}
+
+// DeleteSubscription removes the authenticated user's subscription to the
+// repository, returning to being notified only when participating or
+// mentioned.
+func (c *Client) DeleteSubscription(repo string) error {
+	link := c.apiURL("repos", repo, "subscription")
+	return c.request("DELETE", link, nil, nil)
+}
+
+// DeleteSubscription is a wrapper around DefaultClient.DeleteSubscription.
+func DeleteSubscription(repo string) error {
+	return DefaultClient.DeleteSubscription(repo)
+}
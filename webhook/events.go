@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/calmh/github"
+)
+
+// PingEvent is sent when a webhook is created.
+type PingEvent struct {
+	Zen    string `json:"zen"`
+	HookID int64  `json:"hook_id"`
+}
+
+type IssuesEvent struct {
+	Action     string            `json:"action"` // "opened", "edited", "closed", "labeled", ...
+	Issue      github.Issue      `json:"issue"`
+	Label      *github.Label     `json:"label"`    // for "labeled" and "unlabeled"
+	Assignee   *github.User      `json:"assignee"` // for "assigned" and "unassigned"
+	Repository github.Repository `json:"repository"`
+	Sender     github.User       `json:"sender"`
+}
+
+type IssueCommentEvent struct {
+	Action     string            `json:"action"` // "created", "edited" or "deleted"
+	Issue      github.Issue      `json:"issue"`
+	Comment    github.Comment    `json:"comment"`
+	Repository github.Repository `json:"repository"`
+	Sender     github.User       `json:"sender"`
+}
+
+type PullRequestEvent struct {
+	Action      string             `json:"action"` // "opened", "synchronize", "closed", ...
+	Number      int                `json:"number"`
+	PullRequest github.PullRequest `json:"pull_request"`
+	Repository  github.Repository  `json:"repository"`
+	Sender      github.User        `json:"sender"`
+}
+
+type PullRequestReviewEvent struct {
+	Action      string             `json:"action"` // "submitted", "edited" or "dismissed"
+	Review      github.Review      `json:"review"`
+	PullRequest github.PullRequest `json:"pull_request"`
+	Repository  github.Repository  `json:"repository"`
+	Sender      github.User        `json:"sender"`
+}
+
+type ReleaseEvent struct {
+	Action     string            `json:"action"` // "published", "created", "edited", "deleted", ...
+	Release    github.Release    `json:"release"`
+	Repository github.Repository `json:"repository"`
+	Sender     github.User       `json:"sender"`
+}
+
+// CreateEvent and DeleteEvent are sent when a branch or tag is created or
+// deleted.
+type CreateEvent struct {
+	Ref        string            `json:"ref"`
+	RefType    string            `json:"ref_type"` // "branch" or "tag"
+	Repository github.Repository `json:"repository"`
+	Sender     github.User       `json:"sender"`
+}
+
+type DeleteEvent CreateEvent
+
+type PushEvent struct {
+	Ref        string         `json:"ref"` // "refs/heads/main"
+	Before     string         `json:"before"`
+	After      string         `json:"after"`
+	Created    bool           `json:"created"`
+	Deleted    bool           `json:"deleted"`
+	Forced     bool           `json:"forced"`
+	Compare    string         `json:"compare"`
+	Commits    []PushCommit   `json:"commits"`
+	HeadCommit *PushCommit    `json:"head_commit"` // nil for deletions
+	Pusher     PushAuthor     `json:"pusher"`
+	Repository PushRepository `json:"repository"`
+	Sender     github.User    `json:"sender"`
+}
+
+// PushCommit is a commit in a push event.
+type PushCommit struct {
+	ID        string     `json:"id"`
+	Message   string     `json:"message"`
+	URL       string     `json:"url"`
+	Author    PushAuthor `json:"author"`
+	Committer PushAuthor `json:"committer"`
+	Added     []string   `json:"added"`
+	Removed   []string   `json:"removed"`
+	Modified  []string   `json:"modified"`
+}
+
+type PushAuthor struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// PushRepository is the repository in a push event, which differs from
+// github.Repository in that its timestamps are Unix times.
+type PushRepository struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	HTMLURL       string `json:"html_url"`
+	Private       bool   `json:"private"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// ParsePayload decodes the payload of a delivery of the given event type
+// into the corresponding event type in this package, returned as a
+// pointer. Payloads of other event types are returned as a
+// json.RawMessage.
+func ParsePayload(event string, body []byte) (interface{}, error) {
+	var v interface{}
+	switch event {
+	case "ping":
+		v = new(PingEvent)
+	case "issues":
+		v = new(IssuesEvent)
+	case "issue_comment":
+		v = new(IssueCommentEvent)
+	case "pull_request":
+		v = new(PullRequestEvent)
+	case "pull_request_review":
+		v = new(PullRequestReviewEvent)
+	case "release":
+		v = new(ReleaseEvent)
+	case "create":
+		v = new(CreateEvent)
+	case "delete":
+		v = new(DeleteEvent)
+	case "push":
+		v = new(PushEvent)
+	default:
+		return json.RawMessage(body), nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,134 @@
+// Package webhook receives GitHub webhook deliveries, verifying their
+// signatures and decoding their payloads into typed events.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxPayloadSize is the largest payload GitHub sends.
+const maxPayloadSize = 25 << 20
+
+// ErrInvalidSignature is returned when a delivery is not signed with the
+// expected secret.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Delivery is a received webhook delivery.
+type Delivery struct {
+	ID    string // X-GitHub-Delivery, unique per delivery
+	Event string // X-GitHub-Event: "issues", "push", ...
+
+	// Payload is the decoded payload: one of the event types in this
+	// package, or a json.RawMessage for other events.
+	Payload interface{}
+
+	// Body is the raw JSON payload.
+	Body []byte
+}
+
+// Handler is an http.Handler receiving webhook deliveries. It can be
+// wrapped in github.InvalidationHandler to keep a response cache up to
+// date.
+type Handler struct {
+	// Secret is the webhook secret that deliveries must be signed with.
+	// Signatures are not verified if it is empty, which should only be
+	// the case behind some other authentication.
+	Secret []byte
+
+	// Handle is called for each verified delivery. An error results in a
+	// 500 response, which shows up as a failed delivery on GitHub.
+	Handle func(Delivery) error
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	d, err := h.Parse(req)
+	if errors.Is(err, ErrInvalidSignature) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.Handle != nil {
+		if err := h.Handle(d); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Parse reads, verifies and decodes the delivery in the request.
+func (h *Handler) Parse(req *http.Request) (Delivery, error) {
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, maxPayloadSize+1))
+	if err != nil {
+		return Delivery{}, err
+	}
+	if len(body) > maxPayloadSize {
+		return Delivery{}, errors.New("payload too large")
+	}
+	if len(h.Secret) > 0 {
+		if err := VerifySignature(h.Secret, body, req.Header.Get("X-Hub-Signature-256")); err != nil {
+			return Delivery{}, err
+		}
+	}
+
+	// Deliveries are JSON, or the JSON in the "payload" form field when
+	// the hook is configured for application/x-www-form-urlencoded.
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return Delivery{}, err
+		}
+		body = []byte(form.Get("payload"))
+	}
+
+	d := Delivery{
+		ID:    req.Header.Get("X-GitHub-Delivery"),
+		Event: req.Header.Get("X-GitHub-Event"),
+		Body:  body,
+	}
+	if d.Event == "" {
+		return Delivery{}, errors.New("missing X-GitHub-Event header")
+	}
+	d.Payload, err = ParsePayload(d.Event, body)
+	if err != nil {
+		return Delivery{}, err
+	}
+	return d, nil
+}
+
+// VerifySignature checks the X-Hub-Signature-256 header value against
+// the HMAC-SHA256 of the body with the secret, returning
+// ErrInvalidSignature if it does not match.
+func VerifySignature(secret, body []byte, signature string) error {
+	const prefix = "sha256="
+	if !strings.HasPrefix(signature, prefix) {
+		return ErrInvalidSignature
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}